@@ -0,0 +1,11 @@
+//
+// Purge cached files of a CDN Resource
+//
+resource "yandex_cdn_cache_invalidation" "my_invalidation" {
+  resource_id = yandex_cdn_resource.my_resource.id
+
+  paths = [
+    "/index.html",
+    "/static/*",
+  ]
+}
@@ -0,0 +1,2 @@
+# terraform import yandex_compute_instance_iam_member.<resource Name> "<resource Id>,<role Id>,<subject id>"
+terraform import yandex_compute_instance_iam_member.editor "...,editor,serviceAccount:some_sa_id"
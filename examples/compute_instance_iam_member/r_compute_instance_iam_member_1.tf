@@ -0,0 +1,29 @@
+//
+// Create a new Compute Instance and new IAM Member for it.
+//
+resource "yandex_compute_instance" "vm1" {
+  name        = "test"
+  platform_id = "standard-v3"
+  zone        = "ru-central1-a"
+
+  resources {
+    cores  = 2
+    memory = 4
+  }
+
+  boot_disk {
+    disk_id = yandex_compute_disk.boot-disk.id
+  }
+
+  network_interface {
+    index     = 1
+    subnet_id = yandex_vpc_subnet.foo.id
+  }
+}
+
+resource "yandex_compute_instance_iam_member" "editor" {
+  instance_id = yandex_compute_instance.vm1.id
+  role        = "editor"
+
+  member = "serviceAccount:some_sa_id"
+}
@@ -0,0 +1,2 @@
+# terraform import yandex_container_registry_iam_member.<resource Name> "<registry_id>,<resource Role>,<subject Id>"
+terraform import yandex_container_registry_iam_member.puller "crps9**********k9psn,container-registry.images.puller,userAccount:foo_user_id"
@@ -0,0 +1,14 @@
+//
+// Create a new Container Registry and new IAM Member for it.
+//
+resource "yandex_container_registry" "your-registry" {
+  folder_id = "your-folder-id"
+  name      = "registry-name"
+}
+
+resource "yandex_container_registry_iam_member" "puller" {
+  registry_id = yandex_container_registry.your-registry.id
+  role        = "container-registry.images.puller"
+
+  member = "userAccount:foo_user_id"
+}
@@ -0,0 +1,10 @@
+//
+// Get information about existing Data Transfer endpoint.
+//
+data "yandex_datatransfer_endpoint" "my_endpoint" {
+  name = "my-endpoint"
+}
+
+output "endpoint_id" {
+  value = data.yandex_datatransfer_endpoint.my_endpoint.endpoint_id
+}
@@ -0,0 +1,10 @@
+//
+// Get information about existing Data Transfer transfer.
+//
+data "yandex_datatransfer_transfer" "my_transfer" {
+  transfer_id = "dttnc**********r3bkg"
+}
+
+output "transfer_source_id" {
+  value = data.yandex_datatransfer_transfer.my_transfer.source_id
+}
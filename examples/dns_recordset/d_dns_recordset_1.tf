@@ -0,0 +1,12 @@
+//
+// Get information about existing DNS RecordSet.
+//
+data "yandex_dns_recordset" "foo" {
+  zone_id = yandex_dns_zone.zone1.id
+  name    = "srv.example.com."
+  type    = "A"
+}
+
+output "records" {
+  value = data.yandex_dns_recordset.foo.data
+}
@@ -0,0 +1,2 @@
+# terraform import yandex_dns_zone_iam_member.<resource Name> "<resource Id>,<role Id>,<subject id>"
+terraform import yandex_dns_zone_iam_member.editor "dns9m**********tducf,dns.editor,serviceAccount:some_sa_id"
@@ -0,0 +1,13 @@
+//
+// Create a new DNS Zone and new IAM Member for it.
+//
+resource "yandex_dns_zone" "zone1" {
+  name = "my-private-zone"
+  zone = "example.com."
+}
+
+resource "yandex_dns_zone_iam_member" "editor" {
+  dns_zone_id = yandex_dns_zone.zone1.id
+  role        = "dns.editor"
+  member      = "serviceAccount:some_sa_id"
+}
@@ -0,0 +1,2 @@
+# terraform import yandex_function_version.<resource Name> <version Id>
+terraform import yandex_function_version.blue d4ebr**********k5hbe
@@ -0,0 +1,37 @@
+//
+// Create two versions of Yandex Cloud Function for blue/green deployment.
+//
+resource "yandex_function" "test-function" {
+  name       = "some_name"
+  user_hash  = "v1"
+  runtime    = "python312"
+  entrypoint = "main"
+  memory     = "128"
+  content {
+    zip_filename = "function.zip"
+  }
+}
+
+resource "yandex_function_version" "blue" {
+  function_id = yandex_function.test-function.id
+  runtime     = "python312"
+  entrypoint  = "main"
+  memory      = 128
+  tags        = ["blue"]
+  content {
+    zip_filename = "function-blue.zip"
+  }
+}
+
+resource "yandex_function_version" "green" {
+  function_id       = yandex_function.test-function.id
+  runtime           = "python312"
+  entrypoint        = "main"
+  memory            = 128
+  execution_timeout = "10"
+  tags              = ["green"]
+  package {
+    bucket_name = "functions-bucket"
+    object_name = "function-green.zip"
+  }
+}
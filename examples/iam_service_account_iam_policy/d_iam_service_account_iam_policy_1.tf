@@ -0,0 +1,10 @@
+//
+// Get the current IAM Policy of existing IAM Service Account (SA).
+//
+data "yandex_iam_service_account_iam_policy" "builder" {
+  service_account_id = "aje5a**********qspd3"
+}
+
+output "builder_editors" {
+  value = [for b in data.yandex_iam_service_account_iam_policy.builder.bindings : b.members if b.role == "editor"]
+}
@@ -0,0 +1,59 @@
+//
+// Create a new MDB Kafka Cluster (v2).
+//
+
+resource "yandex_mdb_kafka_cluster_v2" "cluster" {
+  name        = "kafka-cluster"
+  description = "Kafka Test Cluster"
+  network_id  = yandex_vpc_network.test-net.id
+  subnet_ids  = [yandex_vpc_subnet.test-subnet.id]
+  environment = "PRESTABLE"
+
+  labels = {
+    "key1" = "value1"
+  }
+
+  version          = "3.6"
+  zones            = ["ru-central1-a"]
+  brokers_count    = 1
+  assign_public_ip = false
+  schema_registry  = false
+
+  kafka = {
+    resources = {
+      resource_preset_id = "s2.micro"
+      disk_type_id       = "network-ssd"
+      disk_size          = 32
+    }
+    kafka_config = {
+      compression_type           = "COMPRESSION_TYPE_ZSTD"
+      log_retention_hours        = 168
+      num_partitions             = 10
+      default_replication_factor = 1
+      auto_create_topics_enable  = true
+    }
+  }
+
+  maintenance_window = {
+    type = "WEEKLY"
+    day  = "MON"
+    hour = 3
+  }
+
+  security_group_ids  = [yandex_vpc_security_group.test-sgroup.id]
+  deletion_protection = true
+}
+
+// Auxiliary resources
+resource "yandex_vpc_network" "test-net" {}
+
+resource "yandex_vpc_subnet" "test-subnet" {
+  zone           = "ru-central1-a"
+  network_id     = yandex_vpc_network.test-net.id
+  v4_cidr_blocks = ["10.1.0.0/24"]
+}
+
+resource "yandex_vpc_security_group" "test-sgroup" {
+  description = "Test security group"
+  network_id  = yandex_vpc_network.test-net.id
+}
@@ -0,0 +1,15 @@
+//
+// Create a new Message Queue with a structured Dead Letter Queue configuration.
+//
+resource "yandex_message_queue" "example_dlq_source_queue" {
+  name = "ymq_terraform_dlq_source_example"
+
+  dead_letter_queue {
+    dead_letter_queue_arn = yandex_message_queue.example_dlq_queue.arn
+    max_receive_count     = 3
+  }
+}
+
+resource "yandex_message_queue" "example_dlq_queue" {
+  name = "ymq_terraform_dlq_example"
+}
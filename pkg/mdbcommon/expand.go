@@ -3,11 +3,12 @@ package mdbcommon
 import (
 	"context"
 	"fmt"
+	"sort"
 	"strings"
 
+	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/types"
-	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
 	"github.com/yandex-cloud/terraform-provider-yandex/pkg/datasize"
 	"github.com/yandex-cloud/terraform-provider-yandex/pkg/validate"
 	utils "github.com/yandex-cloud/terraform-provider-yandex/pkg/wrappers"
@@ -22,6 +23,23 @@ func ExpandBackupWindow(ctx context.Context, bw types.Object, diags *diag.Diagno
 	if diags.HasError() {
 		return nil
 	}
+
+	if h := backupWindow.Hours.ValueInt64(); h < 0 || h > 23 {
+		diags.AddError(
+			"Failed to expand backup window",
+			fmt.Sprintf("Error while parsing value for 'backup_window_start.hours'. Value must be between 0 and 23, not %d", h),
+		)
+	}
+	if m := backupWindow.Minutes.ValueInt64(); m < 0 || m > 59 {
+		diags.AddError(
+			"Failed to expand backup window",
+			fmt.Sprintf("Error while parsing value for 'backup_window_start.minutes'. Value must be between 0 and 59, not %d", m),
+		)
+	}
+	if diags.HasError() {
+		return nil
+	}
+
 	rs := &timeofday.TimeOfDay{
 		Hours:   int32(backupWindow.Hours.ValueInt64()),
 		Minutes: int32(backupWindow.Minutes.ValueInt64()),
@@ -61,7 +79,7 @@ func ExpandEnvironment[T ~int32](_ context.Context, e types.String, diags *diag.
 		return 0
 	}
 
-	v, ok := environments[e.ValueString()]
+	v, ok := environments[strings.ToUpper(e.ValueString())]
 	if !ok || v == 0 {
 		allowedEnvs := make([]string, 0, len(environments))
 		for k, v := range environments {
@@ -70,6 +88,7 @@ func ExpandEnvironment[T ~int32](_ context.Context, e types.String, diags *diag.
 			}
 			allowedEnvs = append(allowedEnvs, k)
 		}
+		sort.Strings(allowedEnvs)
 
 		diags.AddError(
 			"Failed to parse environment",
@@ -150,6 +169,14 @@ func ExpandBoolWrapper(_ context.Context, b types.Bool, _ *diag.Diagnostics) *wr
 	return wrapperspb.Bool(b.ValueBool())
 }
 
+func ExpandDeletionProtection(_ context.Context, dp types.Bool, _ *diag.Diagnostics) bool {
+	if dp.IsNull() || dp.IsUnknown() {
+		return false
+	}
+
+	return dp.ValueBool()
+}
+
 func ExpandStringWrapper(_ context.Context, s types.String, _ *diag.Diagnostics) *wrapperspb.StringValue {
 	if s.IsNull() || s.IsUnknown() {
 		return nil
@@ -168,6 +195,18 @@ func ExpandSecurityGroupIds(ctx context.Context, sg types.Set, diags *diag.Diagn
 		}
 	}
 
+	for _, id := range securityGroupIds {
+		if err := validate.ResourceID(id); err != nil {
+			diags.AddError(
+				"Failed to expand security group ids",
+				fmt.Sprintf("Error while parsing value for 'security_group_ids': %s", err),
+			)
+		}
+	}
+	if diags.HasError() {
+		return nil
+	}
+
 	return securityGroupIds
 }
 
@@ -188,19 +227,52 @@ func ExpandInt64Wrapper(ctx context.Context, in types.Int64, diags *diag.Diagnos
 	return w
 }
 
+// ExpandRetainPeriod converts a backup retention period in days into its proto
+// wrapper, rejecting negative values.
+func ExpandRetainPeriod(ctx context.Context, rp types.Int64, diags *diag.Diagnostics) *wrapperspb.Int64Value {
+	if !utils.IsPresent(rp) {
+		return nil
+	}
+
+	if rp.ValueInt64() < 0 {
+		diags.AddError(
+			"Failed to expand retain period",
+			fmt.Sprintf("Error while parsing value for 'backup_retain_period_days'. Value must not be negative, not %d", rp.ValueInt64()),
+		)
+		return nil
+	}
+
+	return wrapperspb.Int64(rp.ValueInt64())
+}
+
+// ExpandAccess fills in only the kinds of access supported by the access proto,
+// the object is expected to have AccessAttrTypesOf the proto.
 func ExpandAccess[V any, T accessModel[V]](ctx context.Context, cfgAccess types.Object, diags *diag.Diagnostics) T {
-	var access Access
-	diags.Append(cfgAccess.As(ctx, &access, basetypes.ObjectAsOptions{
-		UnhandledNullAsEmpty:    true,
-		UnhandledUnknownAsEmpty: true,
-	})...)
-	if diags.HasError() {
+	attrTypes := AccessAttrTypesOf[V, T]()
+	if !cfgAccess.IsNull() && !cfgAccess.IsUnknown() && !cfgAccess.Type(ctx).Equal(types.ObjectType{AttrTypes: attrTypes}) {
+		diags.AddError(
+			"Failed to expand access",
+			fmt.Sprintf("Unexpected type of 'access': %s", cfgAccess.Type(ctx)),
+		)
 		return nil
 	}
+
+	attrs := cfgAccess.Attributes()
 	ac := T(new(V))
-	ac.SetDataLens(access.DataLens.ValueBool())
-	ac.SetDataTransfer(access.DataTransfer.ValueBool())
-	ac.SetServerless(access.Serverless.ValueBool())
-	ac.SetWebSql(access.WebSql.ValueBool())
+	ac.SetDataLens(expandAccessAttr(attrs, "data_lens"))
+	ac.SetDataTransfer(expandAccessAttr(attrs, "data_transfer"))
+	ac.SetWebSql(expandAccessAttr(attrs, "web_sql"))
+	if sl, ok := any(ac).(serverlessAccessModel); ok {
+		sl.SetServerless(expandAccessAttr(attrs, "serverless"))
+	}
+	if yq, ok := any(ac).(yandexQueryAccessModel); ok {
+		yq.SetYandexQuery(expandAccessAttr(attrs, "yandex_query"))
+	}
 	return ac
 }
+
+// expandAccessAttr treats missing, null and unknown attributes as disabled access.
+func expandAccessAttr(attrs map[string]attr.Value, name string) bool {
+	v, ok := attrs[name].(types.Bool)
+	return ok && v.ValueBool()
+}
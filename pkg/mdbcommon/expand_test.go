@@ -3,12 +3,14 @@ package mdbcommon
 import (
 	"context"
 	"reflect"
+	"strings"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/yandex-cloud/go-genproto/yandex/cloud/mdb/postgresql/v1"
+	"github.com/yandex-cloud/go-genproto/yandex/cloud/mdb/spqr/v1"
 	"github.com/yandex-cloud/terraform-provider-yandex/pkg/datasize"
 	"google.golang.org/genproto/googleapis/type/timeofday"
 	"google.golang.org/protobuf/types/known/wrapperspb"
@@ -340,13 +342,13 @@ func TestYandexProvider_MDBMySQLClusterSecurityGroupIdsExpand(t *testing.T) {
 	}{
 		{
 			testname:    "CheckSeveralAttributes",
-			reqVal:      types.SetValueMust(types.StringType, []attr.Value{types.StringValue("sg-1"), types.StringValue("sg-2")}),
-			expectedVal: []string{"sg-1", "sg-2"},
+			reqVal:      types.SetValueMust(types.StringType, []attr.Value{types.StringValue("enp1sg00000000000001"), types.StringValue("enp1sg00000000000002")}),
+			expectedVal: []string{"enp1sg00000000000001", "enp1sg00000000000002"},
 		},
 		{
 			testname:    "CheckOneAttribute",
-			reqVal:      types.SetValueMust(types.StringType, []attr.Value{types.StringValue("sg")}),
-			expectedVal: []string{"sg"},
+			reqVal:      types.SetValueMust(types.StringType, []attr.Value{types.StringValue("enp1sg00000000000001")}),
+			expectedVal: []string{"enp1sg00000000000001"},
 		},
 		{
 			testname:    "CheckEmptyAttribute",
@@ -363,6 +365,21 @@ func TestYandexProvider_MDBMySQLClusterSecurityGroupIdsExpand(t *testing.T) {
 			reqVal:        types.SetValueMust(types.Int64Type, []attr.Value{types.Int64Value(1)}),
 			expectedError: true,
 		},
+		{
+			testname:      "CheckIdWithSpaces",
+			reqVal:        types.SetValueMust(types.StringType, []attr.Value{types.StringValue(" enp1sg00000000000001 ")}),
+			expectedError: true,
+		},
+		{
+			testname:      "CheckEmptyId",
+			reqVal:        types.SetValueMust(types.StringType, []attr.Value{types.StringValue("")}),
+			expectedError: true,
+		},
+		{
+			testname:      "CheckOneInvalidId",
+			reqVal:        types.SetValueMust(types.StringType, []attr.Value{types.StringValue("enp1sg00000000000001"), types.StringValue("sg-2")}),
+			expectedError: true,
+		},
 	}
 
 	for _, c := range cases {
@@ -390,6 +407,21 @@ func TestYandexProvider_MDBMySQLClusterSecurityGroupIdsExpand(t *testing.T) {
 	}
 }
 
+func TestYandexProvider_MDBSecurityGroupIdsExpandErrorMessage(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+
+	diags := diag.Diagnostics{}
+	ExpandSecurityGroupIds(ctx, types.SetValueMust(types.StringType, []attr.Value{types.StringValue("sg 1")}), &diags)
+	if !diags.HasError() {
+		t.Fatalf("Expected error for invalid security group id")
+	}
+
+	if detail := diags.Errors()[0].Detail(); !strings.Contains(detail, `"sg 1"`) {
+		t.Errorf("Expected error detail to contain invalid security group id, actual: %s", detail)
+	}
+}
+
 func TestYandexProvider_MDBMySQLClusterBoolWrapperExpand(t *testing.T) {
 	t.Parallel()
 	ctx := context.Background()
@@ -426,6 +458,60 @@ func TestYandexProvider_MDBMySQLClusterBoolWrapperExpand(t *testing.T) {
 	}
 }
 
+func TestYandexProvider_MDBDeletionProtectionExpand(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+
+	cases := []struct {
+		testname    string
+		reqVal      types.Bool
+		expectedVal bool
+	}{
+		{
+			testname:    "CheckTrueAttribute",
+			reqVal:      types.BoolValue(true),
+			expectedVal: true,
+		},
+		{
+			testname:    "CheckFalseAttribute",
+			reqVal:      types.BoolValue(false),
+			expectedVal: false,
+		},
+		{
+			testname:    "CheckNullAttribute",
+			reqVal:      types.BoolNull(),
+			expectedVal: false,
+		},
+		{
+			testname:    "CheckUnknownAttribute",
+			reqVal:      types.BoolUnknown(),
+			expectedVal: false,
+		},
+	}
+
+	for _, c := range cases {
+		diags := diag.Diagnostics{}
+		b := ExpandDeletionProtection(ctx, c.reqVal, &diags)
+		if diags.HasError() {
+			t.Errorf(
+				"Unexpected expand diagnostics status %s test: errors: %v",
+				c.testname,
+				diags.Errors(),
+			)
+			continue
+		}
+
+		if b != c.expectedVal {
+			t.Errorf(
+				"Unexpected expand result value %s test: expected %t, actual %t",
+				c.testname,
+				c.expectedVal,
+				b,
+			)
+		}
+	}
+}
+
 type MockEnvironment int32
 
 const (
@@ -454,11 +540,36 @@ func TestYandexProvider_MDBMySQLClusterEnvironmentExpand(t *testing.T) {
 			reqVal:      types.StringValue("PRESTABLE"),
 			expectedVal: MockEnvironmentPrestable,
 		},
+		{
+			testname:    "CheckLowerCaseAttribute_production",
+			reqVal:      types.StringValue("production"),
+			expectedVal: MockEnvironmentProduction,
+		},
+		{
+			testname:    "CheckLowerCaseAttribute_prestable",
+			reqVal:      types.StringValue("prestable"),
+			expectedVal: MockEnvironmentPrestable,
+		},
+		{
+			testname:    "CheckMixedCaseAttribute_Production",
+			reqVal:      types.StringValue("Production"),
+			expectedVal: MockEnvironmentProduction,
+		},
 		{
 			testname:      "CheckInvalidAttribute",
 			reqVal:        types.StringValue("INVALID"),
 			expectedError: true,
 		},
+		{
+			testname:      "CheckInvalidLowerCaseAttribute",
+			reqVal:        types.StringValue("invalid"),
+			expectedError: true,
+		},
+		{
+			testname:      "CheckExplicitUnspecifiedLowerCaseAttribute",
+			reqVal:        types.StringValue("environment_unspecified"),
+			expectedError: true,
+		},
 		{
 			testname:    "ChecNullAttribute",
 			reqVal:      types.StringNull(),
@@ -496,6 +607,22 @@ func TestYandexProvider_MDBMySQLClusterEnvironmentExpand(t *testing.T) {
 	}
 }
 
+func TestYandexProvider_MDBEnvironmentExpandErrorMessage(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+
+	diags := diag.Diagnostics{}
+	ExpandEnvironment[MockEnvironment](ctx, types.StringValue("testing"), &diags)
+	if !diags.HasError() {
+		t.Fatalf("Unexpected expand diagnostics status: expected error for invalid environment")
+	}
+
+	expected := "Value must be one of `PRESTABLE`, `PRODUCTION`, not `\"testing\"`"
+	if detail := diags.Errors()[0].Detail(); !strings.Contains(detail, expected) {
+		t.Errorf("Unexpected expand diagnostics detail: expected to contain %q, actual %q", expected, detail)
+	}
+}
+
 var expectedBwsAttrTypes = map[string]attr.Type{
 	"hours":   types.Int64Type,
 	"minutes": types.Int64Type,
@@ -514,7 +641,13 @@ func TestYandexProvider_MDBTimeOfDayExpand(t *testing.T) {
 	t.Parallel()
 	ctx := context.Background()
 
-	testInt64 := int64(30)
+	testHours := int64(23)
+	testMinutes := int64(30)
+	minValue := int64(0)
+	maxMinutes := int64(59)
+	negativeValue := int64(-1)
+	outOfRangeHours := int64(24)
+	outOfRangeMinutes := int64(60)
 
 	cases := []struct {
 		testname      string
@@ -524,26 +657,59 @@ func TestYandexProvider_MDBTimeOfDayExpand(t *testing.T) {
 	}{
 		{
 			testname: "CheckAllExplicitAttributes",
-			reqVal:   buildTestBwsObj(&testInt64, &testInt64),
+			reqVal:   buildTestBwsObj(&testHours, &testMinutes),
 			expectedVal: &timeofday.TimeOfDay{
-				Hours:   30,
+				Hours:   23,
 				Minutes: 30,
 			},
 		},
 		{
 			testname: "CheckPartlyAttributesWithHours",
-			reqVal:   buildTestBwsObj(&testInt64, nil),
+			reqVal:   buildTestBwsObj(&testHours, nil),
 			expectedVal: &timeofday.TimeOfDay{
-				Hours: 30,
+				Hours: 23,
 			},
 		},
 		{
 			testname: "CheckPartlyAttributesWithMinutes",
-			reqVal:   buildTestBwsObj(nil, &testInt64),
+			reqVal:   buildTestBwsObj(nil, &testMinutes),
 			expectedVal: &timeofday.TimeOfDay{
 				Minutes: 30,
 			},
 		},
+		{
+			testname:    "CheckLowerBoundaryAttributes",
+			reqVal:      buildTestBwsObj(&minValue, &minValue),
+			expectedVal: &timeofday.TimeOfDay{},
+		},
+		{
+			testname: "CheckUpperBoundaryAttributes",
+			reqVal:   buildTestBwsObj(&testHours, &maxMinutes),
+			expectedVal: &timeofday.TimeOfDay{
+				Hours:   23,
+				Minutes: 59,
+			},
+		},
+		{
+			testname:      "CheckOutOfRangeHours",
+			reqVal:        buildTestBwsObj(&outOfRangeHours, &testMinutes),
+			expectedError: true,
+		},
+		{
+			testname:      "CheckNegativeHours",
+			reqVal:        buildTestBwsObj(&negativeValue, &testMinutes),
+			expectedError: true,
+		},
+		{
+			testname:      "CheckOutOfRangeMinutes",
+			reqVal:        buildTestBwsObj(&testHours, &outOfRangeMinutes),
+			expectedError: true,
+		},
+		{
+			testname:      "CheckNegativeMinutes",
+			reqVal:        buildTestBwsObj(&testHours, &negativeValue),
+			expectedError: true,
+		},
 		{
 			testname:    "CheckWithoutAttributes",
 			reqVal:      buildTestBwsObj(nil, nil),
@@ -635,13 +801,76 @@ func TestYandexProvider_Int64WrapperExpand(t *testing.T) {
 	}
 }
 
-func buildTestAccessObj(dataLens, dataTransfer, webSql, serverless *bool) types.Object {
+func TestYandexProvider_MDBRetainPeriodExpand(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+
+	cases := []struct {
+		testname      string
+		reqVal        types.Int64
+		expectedVal   *wrapperspb.Int64Value
+		expectedError bool
+	}{
+		{
+			testname:    "CheckExplicitAttribute",
+			reqVal:      types.Int64Value(7),
+			expectedVal: wrapperspb.Int64(7),
+		},
+		{
+			testname:    "CheckZeroAttribute",
+			reqVal:      types.Int64Value(0),
+			expectedVal: wrapperspb.Int64(0),
+		},
+		{
+			testname:    "CheckNullAttribute",
+			reqVal:      types.Int64Null(),
+			expectedVal: nil,
+		},
+		{
+			testname:    "CheckUnknownAttribute",
+			reqVal:      types.Int64Unknown(),
+			expectedVal: nil,
+		},
+		{
+			testname:      "CheckNegativeAttribute",
+			reqVal:        types.Int64Value(-1),
+			expectedError: true,
+		},
+	}
+
+	for _, c := range cases {
+		diags := diag.Diagnostics{}
+		rp := ExpandRetainPeriod(ctx, c.reqVal, &diags)
+		if diags.HasError() != c.expectedError {
+			t.Errorf(
+				"Unexpected expand diagnostics status %s test: expected %t, actual %t with errors: %v",
+				c.testname,
+				c.expectedError,
+				diags.HasError(),
+				diags.Errors(),
+			)
+			continue
+		}
+
+		if !reflect.DeepEqual(rp, c.expectedVal) {
+			t.Errorf(
+				"Unexpected expand result value %s test: expected %s, actual %s",
+				c.testname,
+				c.expectedVal,
+				rp,
+			)
+		}
+	}
+}
+
+func buildTestAccessObj(dataLens, dataTransfer, webSql, serverless, yandexQuery *bool) types.Object {
 	return types.ObjectValueMust(
-		AccessAttrTypes, map[string]attr.Value{
+		AccessWithYandexQueryAttrTypes, map[string]attr.Value{
 			"data_transfer": types.BoolPointerValue(dataTransfer),
 			"data_lens":     types.BoolPointerValue(dataLens),
 			"serverless":    types.BoolPointerValue(serverless),
 			"web_sql":       types.BoolPointerValue(webSql),
+			"yandex_query":  types.BoolPointerValue(yandexQuery),
 		},
 	)
 }
@@ -661,16 +890,17 @@ func TestYandexProvider_MDBCommonAccessExpand(t *testing.T) {
 	}{
 		{
 			testname: "CheckAllExplicitAttributes",
-			reqVal:   buildTestAccessObj(&trueAttr, &trueAttr, &falseAttr, &falseAttr),
+			reqVal:   buildTestAccessObj(&trueAttr, &trueAttr, &falseAttr, &falseAttr, &trueAttr),
 			expectedVal: &postgresql.Access{
 				DataLens:     trueAttr,
 				DataTransfer: trueAttr,
+				YandexQuery:  trueAttr,
 			},
 			expectedError: false,
 		},
 		{
 			testname: "CheckPartlyAttributes",
-			reqVal:   buildTestAccessObj(&trueAttr, &falseAttr, nil, nil),
+			reqVal:   buildTestAccessObj(&trueAttr, &falseAttr, nil, nil, nil),
 			expectedVal: &postgresql.Access{
 				DataLens:     trueAttr,
 				DataTransfer: falseAttr,
@@ -679,13 +909,13 @@ func TestYandexProvider_MDBCommonAccessExpand(t *testing.T) {
 		},
 		{
 			testname:      "CheckWithoutAttributes",
-			reqVal:        buildTestAccessObj(nil, nil, nil, nil),
+			reqVal:        buildTestAccessObj(nil, nil, nil, nil, nil),
 			expectedVal:   &postgresql.Access{},
 			expectedError: false,
 		},
 		{
 			testname:      "CheckNullAccess",
-			reqVal:        types.ObjectNull(AccessAttrTypes),
+			reqVal:        types.ObjectNull(AccessWithYandexQueryAttrTypes),
 			expectedVal:   &postgresql.Access{},
 			expectedError: false,
 		},
@@ -723,3 +953,67 @@ func TestYandexProvider_MDBCommonAccessExpand(t *testing.T) {
 		}
 	}
 }
+
+func TestYandexProvider_MDBCommonAccessWithoutYandexQueryExpand(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+
+	cases := []struct {
+		testname      string
+		reqVal        types.Object
+		expectedVal   *spqr.Access
+		expectedError bool
+	}{
+		{
+			testname: "CheckAllExplicitAttributes",
+			reqVal: types.ObjectValueMust(
+				AccessAttrTypes, map[string]attr.Value{
+					"data_transfer": types.BoolValue(true),
+					"data_lens":     types.BoolValue(false),
+					"serverless":    types.BoolValue(true),
+					"web_sql":       types.BoolValue(false),
+				},
+			),
+			expectedVal: &spqr.Access{
+				DataTransfer: true,
+				Serverless:   true,
+			},
+			expectedError: false,
+		},
+		{
+			testname:      "CheckNullAccess",
+			reqVal:        types.ObjectNull(AccessAttrTypes),
+			expectedVal:   &spqr.Access{},
+			expectedError: false,
+		},
+		{
+			testname:      "CheckYandexQueryAttribute",
+			reqVal:        buildTestAccessObj(nil, nil, nil, nil, nil),
+			expectedError: true,
+		},
+	}
+
+	for _, c := range cases {
+		diags := diag.Diagnostics{}
+		access := ExpandAccess[spqr.Access](ctx, c.reqVal, &diags)
+		if diags.HasError() != c.expectedError {
+			t.Errorf(
+				"Unexpected expansion diagnostics status %s test: expected %t, actual %t with errors: %v",
+				c.testname,
+				c.expectedError,
+				diags.HasError(),
+				diags.Errors(),
+			)
+			continue
+		}
+
+		if !reflect.DeepEqual(access, c.expectedVal) {
+			t.Errorf(
+				"Unexpected expansion result value %s test: expected %s, actual %s",
+				c.testname,
+				c.expectedVal,
+				access,
+			)
+		}
+	}
+}
@@ -2,10 +2,13 @@ package mdbcommon
 
 import (
 	"context"
+	"strings"
 
+	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/yandex-cloud/terraform-provider-yandex/pkg/datasize"
+	utils "github.com/yandex-cloud/terraform-provider-yandex/pkg/wrappers"
 	"google.golang.org/genproto/googleapis/type/timeofday"
 	"google.golang.org/protobuf/types/known/wrapperspb"
 )
@@ -39,6 +42,15 @@ func FlattenBackupWindowStart(ctx context.Context, bws *timeofday.TimeOfDay, dia
 	return bwsObj
 }
 
+// FlattenEnvironment keeps the current environment value if it differs from the
+// one returned by the API only in case, as ExpandEnvironment accepts any case.
+func FlattenEnvironment(_ context.Context, current types.String, env string, _ *diag.Diagnostics) types.String {
+	if utils.IsPresent(current) && strings.EqualFold(current.ValueString(), env) {
+		return current
+	}
+	return types.StringValue(env)
+}
+
 func FlattenBoolWrapper(ctx context.Context, wb *wrapperspb.BoolValue, diags *diag.Diagnostics) types.Bool {
 	if wb == nil {
 		return types.BoolNull()
@@ -46,6 +58,10 @@ func FlattenBoolWrapper(ctx context.Context, wb *wrapperspb.BoolValue, diags *di
 	return types.BoolValue(wb.GetValue())
 }
 
+func FlattenDeletionProtection(_ context.Context, dp bool, _ *diag.Diagnostics) types.Bool {
+	return types.BoolValue(dp)
+}
+
 func FlattenStringWrapper(ctx context.Context, ws *wrapperspb.StringValue, diags *diag.Diagnostics) types.String {
 	if ws == nil {
 		return types.StringNull()
@@ -110,19 +126,32 @@ func FlattenInt64Wrapper(ctx context.Context, pgBrpd *wrapperspb.Int64Value, dia
 	return types.Int64Value(pgBrpd.GetValue())
 }
 
+func FlattenRetainPeriod(ctx context.Context, rp *wrapperspb.Int64Value, diags *diag.Diagnostics) types.Int64 {
+	if rp == nil {
+		return types.Int64Null()
+	}
+	return types.Int64Value(rp.GetValue())
+}
+
 func FlattenAccess[V any, T accessModel[V]](ctx context.Context, access T, diags *diag.Diagnostics) types.Object {
+	attrTypes := AccessAttrTypesOf[V, T]()
 	if access == nil {
-		return types.ObjectNull(AccessAttrTypes)
+		return types.ObjectNull(attrTypes)
+	}
+
+	attrs := map[string]attr.Value{
+		"data_lens":     types.BoolValue(access.GetDataLens()),
+		"data_transfer": types.BoolValue(access.GetDataTransfer()),
+		"web_sql":       types.BoolValue(access.GetWebSql()),
+	}
+	if sl, ok := any(access).(serverlessAccessModel); ok {
+		attrs["serverless"] = types.BoolValue(sl.GetServerless())
+	}
+	if yq, ok := any(access).(yandexQueryAccessModel); ok {
+		attrs["yandex_query"] = types.BoolValue(yq.GetYandexQuery())
 	}
 
-	obj, d := types.ObjectValueFrom(
-		ctx, AccessAttrTypes, Access{
-			DataLens:     types.BoolValue(access.GetDataLens()),
-			DataTransfer: types.BoolValue(access.GetDataTransfer()),
-			Serverless:   types.BoolValue(access.GetServerless()),
-			WebSql:       types.BoolValue(access.GetWebSql()),
-		},
-	)
+	obj, d := types.ObjectValue(attrTypes, attrs)
 	diags.Append(d...)
 
 	return obj
@@ -8,6 +8,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/yandex-cloud/go-genproto/yandex/cloud/mdb/postgresql/v1"
+	"github.com/yandex-cloud/go-genproto/yandex/cloud/mdb/spqr/v1"
 	"github.com/yandex-cloud/terraform-provider-yandex/pkg/datasize"
 	"google.golang.org/genproto/googleapis/type/timeofday"
 	"google.golang.org/protobuf/types/known/wrapperspb"
@@ -420,6 +421,115 @@ func TestYandexProvider_BoolWrapperFlatten(t *testing.T) {
 	}
 }
 
+func TestYandexProvider_MDBDeletionProtectionFlatten(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+
+	cases := []struct {
+		testname    string
+		reqVal      bool
+		expectedVal types.Bool
+	}{
+		{
+			testname:    "CheckTrueAttribute",
+			reqVal:      true,
+			expectedVal: types.BoolValue(true),
+		},
+		{
+			testname:    "CheckFalseAttribute",
+			reqVal:      false,
+			expectedVal: types.BoolValue(false),
+		},
+	}
+
+	for _, c := range cases {
+		diags := diag.Diagnostics{}
+		m := FlattenDeletionProtection(ctx, c.reqVal, &diags)
+		if diags.HasError() {
+			t.Errorf(
+				"Unexpected flatten diagnostics status %s test: errors: %v",
+				c.testname,
+				diags.Errors(),
+			)
+			continue
+		}
+
+		if !c.expectedVal.Equal(m) {
+			t.Errorf(
+				"Unexpected flatten result value %s test: expected %s, actual %s",
+				c.testname,
+				c.expectedVal,
+				m,
+			)
+		}
+	}
+}
+
+func TestYandexProvider_MDBEnvironmentFlatten(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+
+	cases := []struct {
+		testname    string
+		current     types.String
+		reqVal      string
+		expectedVal types.String
+	}{
+		{
+			testname:    "CheckNullCurrent",
+			current:     types.StringNull(),
+			reqVal:      "PRODUCTION",
+			expectedVal: types.StringValue("PRODUCTION"),
+		},
+		{
+			testname:    "CheckUnknownCurrent",
+			current:     types.StringUnknown(),
+			reqVal:      "PRODUCTION",
+			expectedVal: types.StringValue("PRODUCTION"),
+		},
+		{
+			testname:    "CheckSameCaseCurrent",
+			current:     types.StringValue("PRESTABLE"),
+			reqVal:      "PRESTABLE",
+			expectedVal: types.StringValue("PRESTABLE"),
+		},
+		{
+			testname:    "CheckLowerCaseCurrent",
+			current:     types.StringValue("prestable"),
+			reqVal:      "PRESTABLE",
+			expectedVal: types.StringValue("prestable"),
+		},
+		{
+			testname:    "CheckChangedCurrent",
+			current:     types.StringValue("prestable"),
+			reqVal:      "PRODUCTION",
+			expectedVal: types.StringValue("PRODUCTION"),
+		},
+	}
+
+	for _, c := range cases {
+		diags := diag.Diagnostics{}
+		env := FlattenEnvironment(ctx, c.current, c.reqVal, &diags)
+		if diags.HasError() {
+			t.Errorf(
+				"Unexpected flatten diagnostics status %s test: errors: %v",
+				c.testname,
+				diags.Errors(),
+			)
+			continue
+		}
+
+		if !c.expectedVal.Equal(env) {
+			t.Errorf(
+				"Unexpected flatten result value %s test: expected %s, actual %s",
+				c.testname,
+				c.expectedVal,
+				env,
+			)
+		}
+	}
+}
+
 func TestYandexProvider_MDBInt64WrapperFlatten(t *testing.T) {
 	t.Parallel()
 	ctx := context.Background()
@@ -466,6 +576,50 @@ func TestYandexProvider_MDBInt64WrapperFlatten(t *testing.T) {
 	}
 }
 
+func TestYandexProvider_MDBRetainPeriodFlatten(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+
+	cases := []struct {
+		testname    string
+		reqVal      *wrapperspb.Int64Value
+		expectedVal types.Int64
+	}{
+		{
+			testname:    "CheckExplicitAttribute",
+			reqVal:      wrapperspb.Int64(7),
+			expectedVal: types.Int64Value(7),
+		},
+		{
+			testname:    "CheckNullAttribute",
+			reqVal:      nil,
+			expectedVal: types.Int64Null(),
+		},
+	}
+
+	for _, c := range cases {
+		diags := diag.Diagnostics{}
+		rp := FlattenRetainPeriod(ctx, c.reqVal, &diags)
+		if diags.HasError() {
+			t.Errorf(
+				"Unexpected flatten diagnostics status %s test: errors: %v",
+				c.testname,
+				diags.Errors(),
+			)
+			continue
+		}
+
+		if !c.expectedVal.Equal(rp) {
+			t.Errorf(
+				"Unexpected flatten result value %s test: expected %s, actual %s",
+				c.testname,
+				c.expectedVal,
+				rp,
+			)
+		}
+	}
+}
+
 func TestYandexProvider_MDBCommonAccessFlattener(t *testing.T) {
 	t.Parallel()
 	ctx := context.Background()
@@ -475,6 +629,7 @@ func TestYandexProvider_MDBCommonAccessFlattener(t *testing.T) {
 		"data_transfer": types.BoolType,
 		"serverless":    types.BoolType,
 		"web_sql":       types.BoolType,
+		"yandex_query":  types.BoolType,
 	}
 
 	cases := []struct {
@@ -485,6 +640,69 @@ func TestYandexProvider_MDBCommonAccessFlattener(t *testing.T) {
 		{
 			testname: "CheckAllAttributes",
 			reqVal: &postgresql.Access{
+				WebSql:      true,
+				DataLens:    true,
+				YandexQuery: true,
+			},
+			expectedVal: types.ObjectValueMust(
+				expectedAccessAttrs, map[string]attr.Value{
+					"data_lens":     types.BoolValue(true),
+					"data_transfer": types.BoolValue(false),
+					"serverless":    types.BoolValue(false),
+					"web_sql":       types.BoolValue(true),
+					"yandex_query":  types.BoolValue(true),
+				},
+			),
+		},
+		{
+			testname:    "CheckNullObject",
+			reqVal:      nil,
+			expectedVal: types.ObjectNull(expectedAccessAttrs),
+		},
+	}
+
+	for _, c := range cases {
+		diags := diag.Diagnostics{}
+		access := FlattenAccess(ctx, c.reqVal, &diags)
+		if diags.HasError() {
+			t.Errorf(
+				"Unexpected flatten diagnostics status %s test: errors: %v",
+				c.testname,
+				diags.Errors(),
+			)
+			continue
+		}
+
+		if !c.expectedVal.Equal(access) {
+			t.Errorf(
+				"Unexpected flatten result value %s test: expected %s, actual %s",
+				c.testname,
+				c.expectedVal,
+				access,
+			)
+		}
+	}
+}
+
+func TestYandexProvider_MDBCommonAccessWithoutYandexQueryFlattener(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+
+	expectedAccessAttrs := map[string]attr.Type{
+		"data_lens":     types.BoolType,
+		"data_transfer": types.BoolType,
+		"serverless":    types.BoolType,
+		"web_sql":       types.BoolType,
+	}
+
+	cases := []struct {
+		testname    string
+		reqVal      *spqr.Access
+		expectedVal types.Object
+	}{
+		{
+			testname: "CheckAllAttributes",
+			reqVal: &spqr.Access{
 				WebSql:   true,
 				DataLens: true,
 			},
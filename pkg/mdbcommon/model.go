@@ -56,17 +56,29 @@ type resourceModel[T any] interface {
 type accessModel[T any] interface {
 	SetDataLens(bool)
 	SetDataTransfer(bool)
-	SetServerless(bool)
 	SetWebSql(bool)
 
 	GetDataLens() bool
 	GetDataTransfer() bool
-	GetServerless() bool
 	GetWebSql() bool
 
 	*T
 }
 
+// serverlessAccessModel is implemented by the access protos of services
+// which support access from Serverless.
+type serverlessAccessModel interface {
+	SetServerless(bool)
+	GetServerless() bool
+}
+
+// yandexQueryAccessModel is implemented by the access protos of services
+// which support access from Yandex Query.
+type yandexQueryAccessModel interface {
+	SetYandexQuery(bool)
+	GetYandexQuery() bool
+}
+
 var ResourceType = types.ObjectType{
 	AttrTypes: map[string]attr.Type{
 		"resource_preset_id": types.StringType,
@@ -143,3 +155,38 @@ var AccessAttrTypes = map[string]attr.Type{
 	"serverless":    types.BoolType,
 	"data_transfer": types.BoolType,
 }
+
+type AccessWithYandexQuery struct {
+	DataLens     types.Bool `tfsdk:"data_lens"`
+	WebSql       types.Bool `tfsdk:"web_sql"`
+	Serverless   types.Bool `tfsdk:"serverless"`
+	DataTransfer types.Bool `tfsdk:"data_transfer"`
+	YandexQuery  types.Bool `tfsdk:"yandex_query"`
+}
+
+var AccessWithYandexQueryAttrTypes = map[string]attr.Type{
+	"data_lens":     types.BoolType,
+	"web_sql":       types.BoolType,
+	"serverless":    types.BoolType,
+	"data_transfer": types.BoolType,
+	"yandex_query":  types.BoolType,
+}
+
+// AccessAttrTypesOf returns the attribute types of the access object for the access proto,
+// which contain only the kinds of access supported by the service.
+func AccessAttrTypesOf[V any, T accessModel[V]]() map[string]attr.Type {
+	attrTypes := map[string]attr.Type{
+		"data_lens":     types.BoolType,
+		"web_sql":       types.BoolType,
+		"data_transfer": types.BoolType,
+	}
+
+	ac := any(T(new(V)))
+	if _, ok := ac.(serverlessAccessModel); ok {
+		attrTypes["serverless"] = types.BoolType
+	}
+	if _, ok := ac.(yandexQueryAccessModel); ok {
+		attrTypes["yandex_query"] = types.BoolType
+	}
+	return attrTypes
+}
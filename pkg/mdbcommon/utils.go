@@ -208,7 +208,7 @@ func FixDiskSizeOnAutoscalingChanges(ctx context.Context, plan, state types.Obje
 	return plan
 }
 
-// ParseStringToTime parse string to time, when s is 0 or is "" then now time format (unix second or "2006-01-02T15:04:05" )
+// ParseStringToTime parse string to time, when s is 0 or is "" then now time format (unix second, RFC3339 or "2006-01-02T15:04:05" )
 func ParseStringToTime(s string) (t time.Time, err error) {
 	if s == "" {
 		return time.Now(), nil
@@ -221,5 +221,9 @@ func ParseStringToTime(s string) (t time.Time, err error) {
 		return time.Unix(int64(timeInt), 0), nil
 	}
 
+	if t, err := time.Parse(time.RFC3339, s); err == nil {
+		return t, nil
+	}
+
 	return time.Parse("2006-01-02T15:04:05", s)
 }
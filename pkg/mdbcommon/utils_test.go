@@ -0,0 +1,65 @@
+package mdbcommon
+
+import (
+	"testing"
+	"time"
+)
+
+func TestYandexProvider_MDBParseStringToTime(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		testname      string
+		reqVal        string
+		expectedVal   time.Time
+		expectedError bool
+	}{
+		{
+			testname:    "CheckUnixSeconds",
+			reqVal:      "1700000000",
+			expectedVal: time.Unix(1700000000, 0),
+		},
+		{
+			testname:    "CheckTimeWithoutZone",
+			reqVal:      "2023-11-14T22:13:20",
+			expectedVal: time.Date(2023, 11, 14, 22, 13, 20, 0, time.UTC),
+		},
+		{
+			testname:    "CheckRFC3339",
+			reqVal:      "2023-11-15T01:13:20+03:00",
+			expectedVal: time.Date(2023, 11, 14, 22, 13, 20, 0, time.UTC),
+		},
+		{
+			testname:    "CheckRFC3339UTC",
+			reqVal:      "2023-11-14T22:13:20Z",
+			expectedVal: time.Date(2023, 11, 14, 22, 13, 20, 0, time.UTC),
+		},
+		{
+			testname:      "CheckInvalidFormat",
+			reqVal:        "2023-11-14 22:13:20",
+			expectedError: true,
+		},
+	}
+
+	for _, c := range cases {
+		res, err := ParseStringToTime(c.reqVal)
+		if (err != nil) != c.expectedError {
+			t.Errorf(
+				"Unexpected parse error status %s test: expected %t, actual %v",
+				c.testname,
+				c.expectedError,
+				err,
+			)
+			continue
+		}
+
+		if !c.expectedError && !res.Equal(c.expectedVal) {
+			t.Errorf(
+				"Unexpected parse result value %s test: expected %v, actual %v",
+				c.testname,
+				c.expectedVal,
+				res,
+			)
+		}
+	}
+}
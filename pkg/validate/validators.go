@@ -1,6 +1,9 @@
 package validate
 
 import (
+	"fmt"
+	"regexp"
+
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
 	"github.com/yandex-cloud/terraform-provider-yandex/common"
@@ -31,3 +34,13 @@ func NetworkId(networkID basetypes.StringValue, config *config.State) (string, d
 	}
 	return networkID.ValueString(), nil
 }
+
+var resourceIDRegexp = regexp.MustCompile("^[a-z0-9]{20}$")
+
+// ResourceID checks that id looks like a Yandex Cloud resource identifier.
+func ResourceID(id string) error {
+	if !resourceIDRegexp.MatchString(id) {
+		return fmt.Errorf("%q is not a valid resource ID: expected 20 lowercase letters and digits", id)
+	}
+	return nil
+}
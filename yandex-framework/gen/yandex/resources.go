@@ -14,14 +14,17 @@ import (
 	"github.com/yandex-cloud/terraform-provider-yandex/yandex-framework/gen/yandex/yandex_compute_gpu_cluster_iam_binding"
 	"github.com/yandex-cloud/terraform-provider-yandex/yandex-framework/gen/yandex/yandex_compute_image_iam_binding"
 	"github.com/yandex-cloud/terraform-provider-yandex/yandex-framework/gen/yandex/yandex_compute_instance_iam_binding"
+	"github.com/yandex-cloud/terraform-provider-yandex/yandex-framework/gen/yandex/yandex_compute_instance_iam_member"
 	"github.com/yandex-cloud/terraform-provider-yandex/yandex-framework/gen/yandex/yandex_compute_placement_group_iam_binding"
 	"github.com/yandex-cloud/terraform-provider-yandex/yandex-framework/gen/yandex/yandex_compute_snapshot_iam_binding"
 	"github.com/yandex-cloud/terraform-provider-yandex/yandex-framework/gen/yandex/yandex_compute_snapshot_schedule_iam_binding"
 	"github.com/yandex-cloud/terraform-provider-yandex/yandex-framework/gen/yandex/yandex_container_registry_iam_binding"
+	"github.com/yandex-cloud/terraform-provider-yandex/yandex-framework/gen/yandex/yandex_container_registry_iam_member"
 	"github.com/yandex-cloud/terraform-provider-yandex/yandex-framework/gen/yandex/yandex_container_repository_iam_binding"
 	"github.com/yandex-cloud/terraform-provider-yandex/yandex-framework/gen/yandex/yandex_datasphere_community_iam_binding"
 	"github.com/yandex-cloud/terraform-provider-yandex/yandex-framework/gen/yandex/yandex_datasphere_project_iam_binding"
 	"github.com/yandex-cloud/terraform-provider-yandex/yandex-framework/gen/yandex/yandex_dns_zone_iam_binding"
+	"github.com/yandex-cloud/terraform-provider-yandex/yandex-framework/gen/yandex/yandex_dns_zone_iam_member"
 	"github.com/yandex-cloud/terraform-provider-yandex/yandex-framework/gen/yandex/yandex_function_iam_binding"
 	"github.com/yandex-cloud/terraform-provider-yandex/yandex-framework/gen/yandex/yandex_iam_service_account_iam_binding"
 	"github.com/yandex-cloud/terraform-provider-yandex/yandex-framework/gen/yandex/yandex_iam_service_account_iam_member"
@@ -53,6 +56,7 @@ import (
 func GetProviderResources() []func() resource.Resource {
 	return []func() resource.Resource{
 		yandex_container_registry_iam_binding.NewResource,
+		yandex_container_registry_iam_member.NewResource,
 		yandex_container_repository_iam_binding.NewResource,
 		yandex_iam_workload_identity_oidc_federation_iam_binding.NewResource,
 		yandex_iam_service_account_iam_binding.NewResource,
@@ -85,12 +89,14 @@ func GetProviderResources() []func() resource.Resource {
 		yandex_compute_gpu_cluster_iam_binding.NewResource,
 		yandex_compute_image_iam_binding.NewResource,
 		yandex_compute_instance_iam_binding.NewResource,
+		yandex_compute_instance_iam_member.NewResource,
 		yandex_compute_placement_group_iam_binding.NewResource,
 		yandex_compute_snapshot_schedule_iam_binding.NewResource,
 		yandex_compute_snapshot_iam_binding.NewResource,
 		yandex_datasphere_community_iam_binding.NewResource,
 		yandex_datasphere_project_iam_binding.NewResource,
 		yandex_dns_zone_iam_binding.NewResource,
+		yandex_dns_zone_iam_member.NewResource,
 		yandex_lockbox_secret_iam_binding.NewResource,
 		yandex_lockbox_secret_iam_member.NewResource,
 		yandex_serverless_container_iam_binding.NewResource,
@@ -70,6 +70,54 @@ func TestAccComputeDisk_basicIamMember(t *testing.T) {
 	})
 }
 
+func TestAccComputeDisk_updateIamBinding(t *testing.T) {
+	var (
+		disk        compute.Disk
+		role        = "viewer"
+		ctx, cancel = context.WithTimeout(context.Background(), timeout)
+		diskName    = acctest.RandomWithPrefix(test.TestPrefix())
+	)
+
+	defer cancel()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { test.AccPreCheck(t) },
+		ProtoV6ProviderFactories: test.AccProviderFactories,
+		CheckDestroy:             testAccCheckComputeDiskDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccComputeDiskWithIAMMember_basic(diskName, role, "allUsers"),
+				Check: resource.ComposeTestCheckFunc(
+					test.TestAccCheckComputeDiskExists("yandex_compute_disk.foobar", &disk, timeout),
+					test.TestAccCheckIamBindingExists(ctx, func() test.BindingsGetter {
+						cfg := test.AccProvider.(*yandex_framework.Provider).GetConfig()
+						return cfg.SDK.Compute().Disk()
+					}, &disk, role, []string{"system:allUsers"}),
+				),
+			},
+			{
+				Config: testAccComputeDiskWithIAMMember_basic(diskName, role, "allAuthenticatedUsers"),
+				Check: resource.ComposeTestCheckFunc(
+					test.TestAccCheckComputeDiskExists("yandex_compute_disk.foobar", &disk, timeout),
+					test.TestAccCheckIamBindingExists(ctx, func() test.BindingsGetter {
+						cfg := test.AccProvider.(*yandex_framework.Provider).GetConfig()
+						return cfg.SDK.Compute().Disk()
+					}, &disk, role, []string{"system:allAuthenticatedUsers"}),
+				),
+			},
+			{
+				ResourceName: "yandex_compute_disk_iam_binding.test-disk-binding",
+				ImportStateIdFunc: func(*terraform.State) (string, error) {
+					return fmt.Sprintf("%s,%s", disk.Id, role), nil
+				},
+				ImportState:                          true,
+				ImportStateVerify:                    true,
+				ImportStateVerifyIdentifierAttribute: "disk_id",
+			},
+		},
+	})
+}
+
 func testAccCheckComputeDiskDestroy(s *terraform.State) error {
 	config := test.AccProvider.(*yandex_framework.Provider).GetConfig()
 
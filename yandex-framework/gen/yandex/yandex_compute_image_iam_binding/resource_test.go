@@ -53,6 +53,51 @@ func TestAccComputeImage_basicIamMember(t *testing.T) {
 	})
 }
 
+func TestAccComputeImage_serviceAccountIamBinding(t *testing.T) {
+	var (
+		image       compute.Image
+		role        = "compute.images.user"
+		ctx, cancel = context.WithTimeout(context.Background(), timeout)
+		imageName   = "image-test-" + acctest.RandString(8)
+		saName      = acctest.RandomWithPrefix("tf-test-image-user")
+	)
+	defer cancel()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { test.AccPreCheck(t) },
+		ProtoV6ProviderFactories: test.AccProviderFactories,
+		CheckDestroy:             testAccCheckComputeImageDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccComputeImageWithIAM_serviceAccount(imageName, saName, role),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckComputeImageExists("yandex_compute_image.foobar", &image),
+					func(s *terraform.State) error {
+						sa, ok := s.RootModule().Resources["yandex_iam_service_account.image-user"]
+						if !ok {
+							return fmt.Errorf("Not found: yandex_iam_service_account.image-user")
+						}
+
+						return test.TestAccCheckIamBindingExists(ctx, func() test.BindingsGetter {
+							cfg := test.AccProvider.(*yandex_framework.Provider).GetConfig()
+							return cfg.SDK.Compute().Image()
+						}, &image, role, []string{"serviceAccount:" + sa.Primary.ID})(s)
+					},
+				),
+			},
+			{
+				ResourceName: "yandex_compute_image_iam_binding.test-image-bind",
+				ImportStateIdFunc: func(*terraform.State) (string, error) {
+					return fmt.Sprintf("%s,%s", image.Id, role), nil
+				},
+				ImportState:                          true,
+				ImportStateVerify:                    true,
+				ImportStateVerifyIdentifierAttribute: "image_id",
+			},
+		},
+	})
+}
+
 func testAccCheckComputeImageExists(n string, image *compute.Image) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		rs, ok := s.RootModule().Resources[n]
@@ -108,6 +153,29 @@ resource "yandex_compute_image_iam_binding" "test-image-bind" {
 `, name, role, userID)
 }
 
+func testAccComputeImageWithIAM_serviceAccount(name, saName, role string) string {
+	return fmt.Sprintf(`
+resource "yandex_compute_image" "foobar" {
+  name          = "%s"
+  description   = "description-test"
+  family        = "ubuntu-1804-lts"
+  source_family = "ubuntu-1804-lts"
+  min_disk_size = 10
+  os_type       = "LINUX"
+}
+
+resource "yandex_iam_service_account" "image-user" {
+  name = "%s"
+}
+
+resource "yandex_compute_image_iam_binding" "test-image-bind" {
+  role     = "%s"
+  members  = ["serviceAccount:${yandex_iam_service_account.image-user.id}"]
+  image_id = yandex_compute_image.foobar.id
+}
+`, name, saName, role)
+}
+
 func testAccCheckComputeImageDestroy(s *terraform.State) error {
 	config := test.AccProvider.(*yandex_framework.Provider).GetConfig()
 
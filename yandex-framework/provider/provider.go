@@ -29,6 +29,7 @@ import (
 	"github.com/yandex-cloud/terraform-provider-yandex/yandex-framework/services/mdb_clickhouse_user"
 	"github.com/yandex-cloud/terraform-provider-yandex/yandex-framework/services/mdb_greenplum_resource_group"
 	"github.com/yandex-cloud/terraform-provider-yandex/yandex-framework/services/mdb_greenplum_user"
+	"github.com/yandex-cloud/terraform-provider-yandex/yandex-framework/services/mdb_kafka_cluster_v2"
 	"github.com/yandex-cloud/terraform-provider-yandex/yandex-framework/services/mdb_mongodb_database"
 	"github.com/yandex-cloud/terraform-provider-yandex/yandex-framework/services/mdb_mongodb_user"
 	"github.com/yandex-cloud/terraform-provider-yandex/yandex-framework/services/mdb_mysql_cluster_v2"
@@ -294,6 +295,7 @@ func (p *Provider) Resources(_ context.Context) []func() resource.Resource {
 		mdb_redis_cluster_v2.NewResource,
 		mdb_redis_user.NewResource,
 		mdb_mysql_cluster_v2.NewMySQLClusterResourceV2,
+		mdb_kafka_cluster_v2.NewKafkaClusterResourceV2,
 		kubernetes_marketplace_helm_release.NewResource,
 		spark_cluster.NewResource,
 		gitlab_instance.NewResource,
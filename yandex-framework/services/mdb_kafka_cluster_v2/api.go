@@ -0,0 +1,155 @@
+package mdb_kafka_cluster_v2
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/yandex-cloud/go-genproto/yandex/cloud/mdb/kafka/v1"
+	"github.com/yandex-cloud/go-genproto/yandex/cloud/operation"
+	ycsdk "github.com/yandex-cloud/go-sdk"
+	"github.com/yandex-cloud/terraform-provider-yandex/pkg/retry"
+)
+
+const defaultMDBPageSize = 1000
+
+var kafkaApi = KafkaAPI{}
+
+type KafkaAPI struct{}
+
+func (r *KafkaAPI) GetCluster(ctx context.Context, sdk *ycsdk.SDK, diags *diag.Diagnostics, cid string) *kafka.Cluster {
+	cluster, err := sdk.MDB().Kafka().Cluster().Get(ctx, &kafka.GetClusterRequest{
+		ClusterId: cid,
+	})
+
+	if err != nil {
+		diags.AddError(
+			"Failed to read resource",
+			fmt.Sprintf("Error while requesting API to read Kafka cluster %q: %s", cid, err.Error()),
+		)
+		return nil
+	}
+	return cluster
+}
+
+func (r *KafkaAPI) ListHosts(ctx context.Context, sdk *ycsdk.SDK, diags *diag.Diagnostics, cid string) []*kafka.Host {
+	hosts := []*kafka.Host{}
+	pageToken := ""
+
+	for {
+		resp, err := sdk.MDB().Kafka().Cluster().ListHosts(ctx, &kafka.ListClusterHostsRequest{
+			ClusterId: cid,
+			PageSize:  defaultMDBPageSize,
+			PageToken: pageToken,
+		})
+		if err != nil {
+			diags.AddError(
+				"Failed to List Kafka Hosts",
+				fmt.Sprintf("Error while requesting API to get hosts of Kafka cluster %q: %s", cid, err.Error()),
+			)
+			return nil
+		}
+
+		hosts = append(hosts, resp.Hosts...)
+
+		if resp.NextPageToken == "" {
+			break
+		}
+		pageToken = resp.NextPageToken
+	}
+
+	return hosts
+}
+
+func (r *KafkaAPI) DeleteCluster(ctx context.Context, sdk *ycsdk.SDK, diags *diag.Diagnostics, cid string) {
+	op, err := sdk.WrapOperation(sdk.MDB().Kafka().Cluster().Delete(ctx, &kafka.DeleteClusterRequest{
+		ClusterId: cid,
+	}))
+
+	if err != nil {
+		diags.AddError(
+			"Failed to delete resource",
+			fmt.Sprintf("Error while requesting API to delete Kafka cluster %q: %s", cid, err.Error()),
+		)
+		return
+	}
+
+	tflog.Debug(ctx, "Deleting Kafka Cluster", map[string]any{"cluster_id": cid})
+
+	if err = op.Wait(ctx); err != nil {
+		diags.AddError(
+			"Failed to delete resource",
+			fmt.Sprintf("Error while waiting for operation %q to delete Kafka cluster %q: %s", op.Id(), cid, err.Error()),
+		)
+	}
+}
+
+func (r *KafkaAPI) CreateCluster(ctx context.Context, sdk *ycsdk.SDK, diags *diag.Diagnostics, req *kafka.CreateClusterRequest) string {
+	op, err := sdk.WrapOperation(sdk.MDB().Kafka().Cluster().Create(ctx, req))
+	if err != nil {
+		diags.AddError(
+			"Failed to create resource",
+			fmt.Sprintf("Error while requesting API to create Kafka cluster: %s", err.Error()),
+		)
+		return ""
+	}
+
+	protoMetadata, err := op.Metadata()
+	if err != nil {
+		diags.AddError(
+			"Failed to create resource",
+			fmt.Sprintf("Error while unmarshaling for operation %q API response metadata: %s", op.Id(), err.Error()),
+		)
+		return ""
+	}
+
+	md, ok := protoMetadata.(*kafka.CreateClusterMetadata)
+	if !ok {
+		diags.AddError(
+			"Failed to create resource",
+			fmt.Sprintf("Error while unmarshaling for operation %q API response metadata", op.Id()),
+		)
+		return ""
+	}
+
+	tflog.Debug(ctx, "Creating Kafka Cluster", map[string]any{"request_body": req})
+
+	if err = op.Wait(ctx); err != nil {
+		diags.AddError(
+			"Failed to create resource",
+			fmt.Sprintf("Error while waiting for operation %q to create Kafka cluster: %s", op.Id(), err.Error()),
+		)
+		return ""
+	}
+
+	return md.ClusterId
+}
+
+func (r *KafkaAPI) UpdateCluster(ctx context.Context, sdk *ycsdk.SDK, diags *diag.Diagnostics, req *kafka.UpdateClusterRequest) {
+	if req == nil || len(req.UpdateMask.Paths) == 0 {
+		return
+	}
+
+	op, err := retry.ConflictingOperation(ctx, sdk, func() (*operation.Operation, error) {
+		log.Printf("[DEBUG] Sending Kafka cluster update request: %+v", req)
+		return sdk.MDB().Kafka().Cluster().Update(ctx, req)
+	})
+	if err != nil {
+		diags.AddError(
+			"Failed to update resource",
+			fmt.Sprintf("Error while requesting API to update Kafka cluster: %s", err.Error()),
+		)
+		return
+	}
+
+	tflog.Debug(ctx, "Updating Kafka Cluster", map[string]any{"request_body": req})
+
+	if err = op.Wait(ctx); err != nil {
+		diags.AddError(
+			"Failed to update resource",
+			fmt.Sprintf("Error while waiting for operation %q to update Kafka cluster: %s", op.Id(), err.Error()),
+		)
+	}
+}
@@ -0,0 +1,189 @@
+package mdb_kafka_cluster_v2
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/yandex-cloud/go-genproto/yandex/cloud/mdb/kafka/v1"
+	protobuf_adapter "github.com/yandex-cloud/terraform-provider-yandex/pkg/adapters/protobuf"
+	"github.com/yandex-cloud/terraform-provider-yandex/pkg/datasize"
+	"github.com/yandex-cloud/terraform-provider-yandex/pkg/mdbcommon"
+	utils "github.com/yandex-cloud/terraform-provider-yandex/pkg/wrappers"
+)
+
+// Set access to default if null
+func expandAccess(ctx context.Context, cfgAccess types.Object, diags *diag.Diagnostics) *kafka.Access {
+	var access Access
+	diags.Append(cfgAccess.As(ctx, &access, basetypes.ObjectAsOptions{
+		UnhandledNullAsEmpty:    true,
+		UnhandledUnknownAsEmpty: true,
+	})...)
+	if diags.HasError() {
+		return nil
+	}
+	return &kafka.Access{
+		DataTransfer: access.DataTransfer.ValueBool(),
+	}
+}
+
+func expandEnabled(ctx context.Context, o types.Object, diags *diag.Diagnostics) bool {
+	var e Enabled
+	diags.Append(o.As(ctx, &e, basetypes.ObjectAsOptions{
+		UnhandledNullAsEmpty:    true,
+		UnhandledUnknownAsEmpty: true,
+	})...)
+	return e.Enabled.ValueBool()
+}
+
+func expandRestAPIConfig(ctx context.Context, o types.Object, diags *diag.Diagnostics) *kafka.ConfigSpec_RestAPIConfig {
+	return &kafka.ConfigSpec_RestAPIConfig{
+		Enabled: expandEnabled(ctx, o, diags),
+	}
+}
+
+func expandKafkaUIConfig(ctx context.Context, o types.Object, diags *diag.Diagnostics) *kafka.ConfigSpec_KafkaUIConfig {
+	return &kafka.ConfigSpec_KafkaUIConfig{
+		Enabled: expandEnabled(ctx, o, diags),
+	}
+}
+
+func expandDiskSizeAutoscaling(ctx context.Context, dsa types.Object, diags *diag.Diagnostics) *kafka.DiskSizeAutoscaling {
+	if dsa.IsNull() || dsa.IsUnknown() {
+		return nil
+	}
+	var dsaConf DiskSizeAutoscaling
+
+	diags.Append(dsa.As(ctx, &dsaConf, datasize.DefaultOpts)...)
+	if diags.HasError() {
+		return nil
+	}
+
+	return &kafka.DiskSizeAutoscaling{
+		DiskSizeLimit:           datasize.ToBytes(dsaConf.DiskSizeLimit.ValueInt64()),
+		PlannedUsageThreshold:   dsaConf.PlannedUsageThreshold.ValueInt64(),
+		EmergencyUsageThreshold: dsaConf.EmergencyUsageThreshold.ValueInt64(),
+	}
+}
+
+func expandZones(ctx context.Context, zones types.List, diags *diag.Diagnostics) []string {
+	var res []string
+	if !utils.IsPresent(zones) {
+		return res
+	}
+	diags.Append(zones.ElementsAs(ctx, &res, false)...)
+	return res
+}
+
+func expandStringSet(ctx context.Context, s types.Set, diags *diag.Diagnostics) []string {
+	var res []string
+	if !utils.IsPresent(s) {
+		return res
+	}
+	diags.Append(s.ElementsAs(ctx, &res, false)...)
+	return res
+}
+
+// getKafkaConfigVersionSuffix returns the suffix of the kafka_config oneof field for the version.
+// Kafka 2.x versions have their own config structure, all other versions use kafka_config_3.
+func getKafkaConfigVersionSuffix(version string) string {
+	if strings.HasPrefix(version, "2") {
+		return strings.ReplaceAll(version, ".", "_")
+	}
+	return "3"
+}
+
+func expandKafkaConfig(
+	ctx context.Context,
+	version string, config mdbcommon.SettingsMapValue,
+	diags *diag.Diagnostics,
+) kafka.ConfigSpec_Kafka_KafkaConfig {
+	a := protobuf_adapter.NewProtobufMapDataAdapter()
+	attrs := config.PrimitiveElements(ctx, diags)
+
+	switch suffix := getKafkaConfigVersionSuffix(version); suffix {
+	case "3":
+		kc := &kafka.ConfigSpec_Kafka_KafkaConfig_3{}
+		a.Fill(ctx, kc, attrs, diags)
+		return kc
+	case "2_8":
+		kc := &kafka.ConfigSpec_Kafka_KafkaConfig_2_8{}
+		a.Fill(ctx, kc, attrs, diags)
+		return kc
+	default:
+		diags.AddError("Failed to expand Kafka config.", fmt.Sprintf("unsupported version %s.", version))
+		return nil
+	}
+}
+
+func expandKafka(ctx context.Context, version string, k types.Object, diags *diag.Diagnostics) *kafka.ConfigSpec_Kafka {
+	if !utils.IsPresent(k) {
+		return nil
+	}
+
+	var kafkaConf Kafka
+	diags.Append(k.As(ctx, &kafkaConf, datasize.UnhandledOpts)...)
+	if diags.HasError() {
+		return nil
+	}
+
+	res := &kafka.ConfigSpec_Kafka{
+		Resources: mdbcommon.ExpandResources[kafka.Resources](ctx, kafkaConf.Resources, diags),
+	}
+	if utils.IsPresent(kafkaConf.KafkaConfig) {
+		res.KafkaConfig = expandKafkaConfig(ctx, version, kafkaConf.KafkaConfig, diags)
+	}
+
+	return res
+}
+
+func expandSubclusterResources(ctx context.Context, s types.Object, diags *diag.Diagnostics) *kafka.Resources {
+	if !utils.IsPresent(s) {
+		return nil
+	}
+
+	var sc Subcluster
+	diags.Append(s.As(ctx, &sc, datasize.UnhandledOpts)...)
+	if diags.HasError() {
+		return nil
+	}
+
+	return mdbcommon.ExpandResources[kafka.Resources](ctx, sc.Resources, diags)
+}
+
+func expandZookeeper(ctx context.Context, z types.Object, diags *diag.Diagnostics) *kafka.ConfigSpec_Zookeeper {
+	r := expandSubclusterResources(ctx, z, diags)
+	if r == nil {
+		return nil
+	}
+	return &kafka.ConfigSpec_Zookeeper{Resources: r}
+}
+
+func expandKraft(ctx context.Context, k types.Object, diags *diag.Diagnostics) *kafka.ConfigSpec_KRaft {
+	r := expandSubclusterResources(ctx, k, diags)
+	if r == nil {
+		return nil
+	}
+	return &kafka.ConfigSpec_KRaft{Resources: r}
+}
+
+func expandConfig(ctx context.Context, configSpec Config, diags *diag.Diagnostics) *kafka.ConfigSpec {
+	version := configSpec.Version.ValueString()
+	return &kafka.ConfigSpec{
+		Version:             version,
+		ZoneId:              expandZones(ctx, configSpec.Zones, diags),
+		BrokersCount:        mdbcommon.ExpandInt64Wrapper(ctx, configSpec.BrokersCount, diags),
+		AssignPublicIp:      configSpec.AssignPublicIp.ValueBool(),
+		SchemaRegistry:      configSpec.SchemaRegistry.ValueBool(),
+		Access:              expandAccess(ctx, configSpec.Access, diags),
+		RestApiConfig:       expandRestAPIConfig(ctx, configSpec.RestAPI, diags),
+		KafkaUiConfig:       expandKafkaUIConfig(ctx, configSpec.KafkaUI, diags),
+		DiskSizeAutoscaling: expandDiskSizeAutoscaling(ctx, configSpec.DiskSizeAutoscaling, diags),
+		Kafka:               expandKafka(ctx, version, configSpec.Kafka, diags),
+		Zookeeper:           expandZookeeper(ctx, configSpec.Zookeeper, diags),
+		Kraft:               expandKraft(ctx, configSpec.Kraft, diags),
+	}
+}
@@ -0,0 +1,286 @@
+package mdb_kafka_cluster_v2
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/yandex-cloud/go-genproto/yandex/cloud/mdb/kafka/v1"
+	"github.com/yandex-cloud/terraform-provider-yandex/pkg/datasize"
+	"github.com/yandex-cloud/terraform-provider-yandex/pkg/mdbcommon"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+)
+
+func buildTestResourcesObj(preset string, diskSize int64, diskType string) types.Object {
+	return types.ObjectValueMust(
+		ResourcesAttrTypes, map[string]attr.Value{
+			"resource_preset_id": types.StringValue(preset),
+			"disk_size":          types.Int64Value(diskSize),
+			"disk_type_id":       types.StringValue(diskType),
+		},
+	)
+}
+
+func buildTestKafkaObj(resources types.Object, config mdbcommon.SettingsMapValue) types.Object {
+	return types.ObjectValueMust(
+		KafkaAttrTypes, map[string]attr.Value{
+			"resources":    resources,
+			"kafka_config": config,
+		},
+	)
+}
+
+func buildTestSubclusterObj(resources types.Object) types.Object {
+	return types.ObjectValueMust(
+		SubclusterAttrTypes, map[string]attr.Value{
+			"resources": resources,
+		},
+	)
+}
+
+func TestYandexProvider_MDBKafkaClusterConfigAccessExpand(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+
+	cases := []struct {
+		testname      string
+		reqVal        types.Object
+		expectedVal   *kafka.Access
+		expectedError bool
+	}{
+		{
+			testname: "CheckExplicitAttributes",
+			reqVal: types.ObjectValueMust(AccessAttrTypes, map[string]attr.Value{
+				"data_transfer": types.BoolValue(true),
+			}),
+			expectedVal: &kafka.Access{DataTransfer: true},
+		},
+		{
+			testname:    "CheckNullAccess",
+			reqVal:      types.ObjectNull(AccessAttrTypes),
+			expectedVal: &kafka.Access{},
+		},
+		{
+			testname: "CheckAccessWithRandomAttributes",
+			reqVal: types.ObjectValueMust(
+				map[string]attr.Type{"random": types.StringType},
+				map[string]attr.Value{"random": types.StringValue("s1")},
+			),
+			expectedError: true,
+		},
+	}
+
+	for _, c := range cases {
+		diags := diag.Diagnostics{}
+		access := expandAccess(ctx, c.reqVal, &diags)
+		if diags.HasError() != c.expectedError {
+			t.Errorf(
+				"Unexpected expansion diagnostics status %s test: expected %t, actual %t with errors: %v",
+				c.testname,
+				c.expectedError,
+				diags.HasError(),
+				diags.Errors(),
+			)
+			continue
+		}
+
+		if !reflect.DeepEqual(access, c.expectedVal) {
+			t.Errorf(
+				"Unexpected expansion result value %s test: expected %s, actual %s",
+				c.testname,
+				c.expectedVal,
+				access,
+			)
+		}
+	}
+}
+
+func TestYandexProvider_MDBKafkaClusterKafkaConfigExpand(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+
+	cases := []struct {
+		testname      string
+		version       string
+		reqVal        mdbcommon.SettingsMapValue
+		expectedVal   kafka.ConfigSpec_Kafka_KafkaConfig
+		expectedError bool
+	}{
+		{
+			testname: "CheckKafka3Config",
+			version:  "3.6",
+			reqVal: NewKafkaSettingsMapValueMust(map[string]attr.Value{
+				"compression_type":           types.Int64Value(int64(kafka.CompressionType_COMPRESSION_TYPE_ZSTD)),
+				"num_partitions":             types.Int64Value(3),
+				"default_replication_factor": types.Int64Value(1),
+				"auto_create_topics_enable":  types.BoolValue(true),
+				"sasl_enabled_mechanisms": types.TupleValueMust(
+					[]attr.Type{types.Int64Type, types.Int64Type},
+					[]attr.Value{
+						types.Int64Value(int64(kafka.SaslMechanism_SASL_MECHANISM_SCRAM_SHA_256)),
+						types.Int64Value(int64(kafka.SaslMechanism_SASL_MECHANISM_SCRAM_SHA_512)),
+					},
+				),
+				"ssl_cipher_suites": types.TupleValueMust(
+					[]attr.Type{types.StringType, types.StringType},
+					[]attr.Value{
+						types.StringValue("TLS_DHE_RSA_WITH_AES_128_CBC_SHA"),
+						types.StringValue("TLS_ECDHE_RSA_WITH_AES_128_GCM_SHA256"),
+					},
+				),
+			}),
+			expectedVal: &kafka.ConfigSpec_Kafka_KafkaConfig_3{
+				KafkaConfig_3: &kafka.KafkaConfig3{
+					CompressionType:          kafka.CompressionType_COMPRESSION_TYPE_ZSTD,
+					NumPartitions:            wrapperspb.Int64(3),
+					DefaultReplicationFactor: wrapperspb.Int64(1),
+					AutoCreateTopicsEnable:   wrapperspb.Bool(true),
+					SaslEnabledMechanisms: []kafka.SaslMechanism{
+						kafka.SaslMechanism_SASL_MECHANISM_SCRAM_SHA_256,
+						kafka.SaslMechanism_SASL_MECHANISM_SCRAM_SHA_512,
+					},
+					SslCipherSuites: []string{
+						"TLS_DHE_RSA_WITH_AES_128_CBC_SHA",
+						"TLS_ECDHE_RSA_WITH_AES_128_GCM_SHA256",
+					},
+				},
+			},
+		},
+		{
+			testname: "CheckKafka28Config",
+			version:  "2.8",
+			reqVal: NewKafkaSettingsMapValueMust(map[string]attr.Value{
+				"log_retention_hours": types.Int64Value(24),
+			}),
+			expectedVal: &kafka.ConfigSpec_Kafka_KafkaConfig_2_8{
+				KafkaConfig_2_8: &kafka.KafkaConfig2_8{
+					LogRetentionHours: wrapperspb.Int64(24),
+				},
+			},
+		},
+		{
+			testname: "CheckUnknownAttribute",
+			version:  "3.6",
+			reqVal: NewKafkaSettingsMapValueMust(map[string]attr.Value{
+				"unknown_attribute": types.Int64Value(1),
+			}),
+			expectedError: true,
+		},
+		{
+			testname: "CheckUnsupportedVersion",
+			version:  "2.6",
+			reqVal: NewKafkaSettingsMapValueMust(map[string]attr.Value{
+				"num_partitions": types.Int64Value(3),
+			}),
+			expectedError: true,
+		},
+	}
+
+	for _, c := range cases {
+		diags := diag.Diagnostics{}
+		conf := expandKafkaConfig(ctx, c.version, c.reqVal, &diags)
+		if diags.HasError() != c.expectedError {
+			t.Errorf(
+				"Unexpected expansion diagnostics status %s test: expected %t, actual %t with errors: %v",
+				c.testname,
+				c.expectedError,
+				diags.HasError(),
+				diags.Errors(),
+			)
+			continue
+		}
+
+		if c.expectedError {
+			continue
+		}
+
+		if !reflect.DeepEqual(conf, c.expectedVal) {
+			t.Errorf(
+				"Unexpected expansion result value %s test: expected %v, actual %v",
+				c.testname,
+				c.expectedVal,
+				conf,
+			)
+		}
+	}
+}
+
+func TestYandexProvider_MDBKafkaClusterConfigExpand(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+
+	cfg := Config{
+		Version: types.StringValue("3.6"),
+		Zones: types.ListValueMust(types.StringType, []attr.Value{
+			types.StringValue("ru-central1-a"),
+			types.StringValue("ru-central1-b"),
+		}),
+		BrokersCount:   types.Int64Value(2),
+		AssignPublicIp: types.BoolValue(false),
+		SchemaRegistry: types.BoolValue(true),
+		Access:         types.ObjectNull(AccessAttrTypes),
+		RestAPI: types.ObjectValueMust(EnabledAttrTypes, map[string]attr.Value{
+			"enabled": types.BoolValue(true),
+		}),
+		KafkaUI: types.ObjectNull(EnabledAttrTypes),
+		DiskSizeAutoscaling: types.ObjectValueMust(DiskSizeAutoscalingAttrTypes, map[string]attr.Value{
+			"disk_size_limit":           types.Int64Value(40),
+			"planned_usage_threshold":   types.Int64Value(0),
+			"emergency_usage_threshold": types.Int64Value(90),
+		}),
+		Kafka: buildTestKafkaObj(
+			buildTestResourcesObj("s2.micro", 16, "network-ssd"),
+			NewKafkaSettingsMapValueMust(map[string]attr.Value{
+				"num_partitions": types.Int64Value(3),
+			}),
+		),
+		Zookeeper: types.ObjectNull(SubclusterAttrTypes),
+		Kraft:     buildTestSubclusterObj(buildTestResourcesObj("s2.micro", 10, "network-ssd")),
+	}
+
+	expected := &kafka.ConfigSpec{
+		Version:        "3.6",
+		ZoneId:         []string{"ru-central1-a", "ru-central1-b"},
+		BrokersCount:   wrapperspb.Int64(2),
+		SchemaRegistry: true,
+		Access:         &kafka.Access{},
+		RestApiConfig:  &kafka.ConfigSpec_RestAPIConfig{Enabled: true},
+		KafkaUiConfig:  &kafka.ConfigSpec_KafkaUIConfig{},
+		DiskSizeAutoscaling: &kafka.DiskSizeAutoscaling{
+			DiskSizeLimit:           datasize.ToBytes(40),
+			EmergencyUsageThreshold: 90,
+		},
+		Kafka: &kafka.ConfigSpec_Kafka{
+			Resources: &kafka.Resources{
+				ResourcePresetId: "s2.micro",
+				DiskSize:         datasize.ToBytes(16),
+				DiskTypeId:       "network-ssd",
+			},
+			KafkaConfig: &kafka.ConfigSpec_Kafka_KafkaConfig_3{
+				KafkaConfig_3: &kafka.KafkaConfig3{
+					NumPartitions: wrapperspb.Int64(3),
+				},
+			},
+		},
+		Kraft: &kafka.ConfigSpec_KRaft{
+			Resources: &kafka.Resources{
+				ResourcePresetId: "s2.micro",
+				DiskSize:         datasize.ToBytes(10),
+				DiskTypeId:       "network-ssd",
+			},
+		},
+	}
+
+	diags := diag.Diagnostics{}
+	res := expandConfig(ctx, cfg, &diags)
+	if diags.HasError() {
+		t.Fatalf("Unexpected expansion diagnostics: %v", diags.Errors())
+	}
+
+	if !reflect.DeepEqual(res, expected) {
+		t.Fatalf("Unexpected expansion result value: expected %s, actual %s", expected, res)
+	}
+}
@@ -0,0 +1,209 @@
+package mdb_kafka_cluster_v2
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/yandex-cloud/go-genproto/yandex/cloud/mdb/kafka/v1"
+	protobuf_adapter "github.com/yandex-cloud/terraform-provider-yandex/pkg/adapters/protobuf"
+	"github.com/yandex-cloud/terraform-provider-yandex/pkg/datasize"
+	"github.com/yandex-cloud/terraform-provider-yandex/pkg/mdbcommon"
+	utils "github.com/yandex-cloud/terraform-provider-yandex/pkg/wrappers"
+)
+
+func flattenAccess(ctx context.Context, a *kafka.Access, diags *diag.Diagnostics) types.Object {
+	if a == nil {
+		return types.ObjectNull(AccessAttrTypes)
+	}
+
+	obj, d := types.ObjectValueFrom(
+		ctx, AccessAttrTypes, Access{
+			DataTransfer: types.BoolValue(a.DataTransfer),
+		},
+	)
+	diags.Append(d...)
+
+	return obj
+}
+
+// flattenHostsSubnetIds returns distinct subnet ids of the cluster hosts.
+func flattenHostsSubnetIds(hosts []*kafka.Host) []string {
+	seen := make(map[string]struct{}, len(hosts))
+	res := []string{}
+	for _, h := range hosts {
+		if h.GetSubnetId() == "" {
+			continue
+		}
+		if _, ok := seen[h.GetSubnetId()]; ok {
+			continue
+		}
+		seen[h.GetSubnetId()] = struct{}{}
+		res = append(res, h.GetSubnetId())
+	}
+	sort.Strings(res)
+	return res
+}
+
+func flattenEnabled(ctx context.Context, enabled bool, diags *diag.Diagnostics) types.Object {
+	obj, d := types.ObjectValueFrom(
+		ctx, EnabledAttrTypes, Enabled{
+			Enabled: types.BoolValue(enabled),
+		},
+	)
+	diags.Append(d...)
+
+	return obj
+}
+
+func flattenDiskSizeAutoscaling(ctx context.Context, dsa *kafka.DiskSizeAutoscaling, diags *diag.Diagnostics) types.Object {
+	if dsa == nil {
+		return types.ObjectNull(DiskSizeAutoscalingAttrTypes)
+	}
+
+	obj, d := types.ObjectValueFrom(
+		ctx, DiskSizeAutoscalingAttrTypes, DiskSizeAutoscaling{
+			DiskSizeLimit:           types.Int64Value(datasize.ToGigabytes(dsa.GetDiskSizeLimit())),
+			PlannedUsageThreshold:   types.Int64Value(dsa.PlannedUsageThreshold),
+			EmergencyUsageThreshold: types.Int64Value(dsa.EmergencyUsageThreshold),
+		},
+	)
+	diags.Append(d...)
+
+	return obj
+}
+
+func flattenZones(ctx context.Context, zones []string, diags *diag.Diagnostics) types.List {
+	l, d := types.ListValueFrom(ctx, types.StringType, zones)
+	diags.Append(d...)
+	return l
+}
+
+func flattenKafkaConfig(ctx context.Context, k *kafka.ConfigSpec_Kafka, diags *diag.Diagnostics) mdbcommon.SettingsMapValue {
+	var src any
+	switch c := k.GetKafkaConfig().(type) {
+	case *kafka.ConfigSpec_Kafka_KafkaConfig_3:
+		src = c.KafkaConfig_3
+	case *kafka.ConfigSpec_Kafka_KafkaConfig_2_8:
+		src = c.KafkaConfig_2_8
+	default:
+		mv, d := NewKafkaSettingsMapValue(map[string]attr.Value{})
+		diags.Append(d...)
+		return mv
+	}
+
+	a := protobuf_adapter.NewProtobufMapDataAdapter()
+	attrs := a.Extract(ctx, src, diags)
+	if diags.HasError() {
+		return NewKafkaSettingsMapNull()
+	}
+
+	attrsPresent := make(map[string]attr.Value)
+	for attr, val := range attrs {
+		if ok := mdbcommon.IsAttrZeroValue(val, diags); !ok {
+			attrsPresent[attr] = val
+		}
+
+		if diags.HasError() {
+			diags.AddError("Flatten Kafka Config Error", fmt.Sprintf("Can't check zero attribute %s", attr))
+		}
+	}
+
+	mv, d := NewKafkaSettingsMapValue(attrsPresent)
+	diags.Append(d...)
+	return mv
+}
+
+func flattenKafka(ctx context.Context, stateKafka types.Object, k *kafka.ConfigSpec_Kafka, diags *diag.Diagnostics) types.Object {
+	if k == nil {
+		return types.ObjectNull(KafkaAttrTypes)
+	}
+
+	kafkaConfig := flattenKafkaConfig(ctx, k, diags)
+	if utils.IsPresent(stateKafka) {
+		var sk Kafka
+		diags.Append(stateKafka.As(ctx, &sk, datasize.UnhandledOpts)...)
+		if diags.HasError() {
+			return types.ObjectNull(KafkaAttrTypes)
+		}
+		if !sk.KafkaConfig.IsNull() && !sk.KafkaConfig.IsUnknown() {
+			kafkaConfig = flattenUserKafkaConfig(ctx, sk.KafkaConfig, kafkaConfig, diags)
+		}
+	}
+
+	obj, d := types.ObjectValueFrom(
+		ctx, KafkaAttrTypes, Kafka{
+			Resources:   mdbcommon.FlattenResources(ctx, k.Resources, diags),
+			KafkaConfig: kafkaConfig,
+		},
+	)
+	diags.Append(d...)
+
+	return obj
+}
+
+// flattenUserKafkaConfig keeps only the keys defined by user and takes their values from the API.
+// A key missing in the API response keeps its state value, because the API omits zero values.
+func flattenUserKafkaConfig(ctx context.Context, stateConfig, apiConfig mdbcommon.SettingsMapValue, diags *diag.Diagnostics) mdbcommon.SettingsMapValue {
+	stateAttrs := stateConfig.PrimitiveElements(ctx, diags)
+	apiAttrs := apiConfig.PrimitiveElements(ctx, diags)
+	if diags.HasError() {
+		return NewKafkaSettingsMapNull()
+	}
+
+	attrs := make(map[string]attr.Value, len(stateAttrs))
+	for key, val := range stateAttrs {
+		attrs[key] = val
+		if apiVal, ok := apiAttrs[key]; ok && !apiVal.Equal(val) {
+			attrs[key] = apiVal
+		}
+	}
+
+	mv, d := NewKafkaSettingsMapValue(attrs)
+	diags.Append(d...)
+	return mv
+}
+
+func flattenSubcluster(ctx context.Context, r *kafka.Resources, diags *diag.Diagnostics) types.Object {
+	if r == nil {
+		return types.ObjectNull(SubclusterAttrTypes)
+	}
+
+	obj, d := types.ObjectValueFrom(
+		ctx, SubclusterAttrTypes, Subcluster{
+			Resources: mdbcommon.FlattenResources(ctx, r, diags),
+		},
+	)
+	diags.Append(d...)
+
+	return obj
+}
+
+func flattenConfig(
+	ctx context.Context,
+	stateKafka types.Object,
+	c *kafka.ConfigSpec, diags *diag.Diagnostics,
+) Config {
+	if c == nil {
+		diags.AddError("Failed to flatten config.", "Config of cluster can't be nil. It's error in provider")
+		return Config{}
+	}
+
+	return Config{
+		Version:             types.StringValue(c.Version),
+		Zones:               flattenZones(ctx, c.ZoneId, diags),
+		BrokersCount:        mdbcommon.FlattenInt64Wrapper(ctx, c.BrokersCount, diags),
+		AssignPublicIp:      types.BoolValue(c.AssignPublicIp),
+		SchemaRegistry:      types.BoolValue(c.SchemaRegistry),
+		Access:              flattenAccess(ctx, c.Access, diags),
+		RestAPI:             flattenEnabled(ctx, c.GetRestApiConfig().GetEnabled(), diags),
+		KafkaUI:             flattenEnabled(ctx, c.GetKafkaUiConfig().GetEnabled(), diags),
+		DiskSizeAutoscaling: flattenDiskSizeAutoscaling(ctx, c.DiskSizeAutoscaling, diags),
+		Kafka:               flattenKafka(ctx, stateKafka, c.Kafka, diags),
+		Zookeeper:           flattenSubcluster(ctx, c.GetZookeeper().GetResources(), diags),
+		Kraft:               flattenSubcluster(ctx, c.GetKraft().GetResources(), diags),
+	}
+}
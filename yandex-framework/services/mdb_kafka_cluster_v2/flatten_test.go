@@ -0,0 +1,182 @@
+package mdb_kafka_cluster_v2
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/yandex-cloud/go-genproto/yandex/cloud/mdb/kafka/v1"
+	"github.com/yandex-cloud/terraform-provider-yandex/pkg/datasize"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+)
+
+var testKafkaSpec = &kafka.ConfigSpec_Kafka{
+	Resources: &kafka.Resources{
+		ResourcePresetId: "s2.micro",
+		DiskSize:         datasize.ToBytes(16),
+		DiskTypeId:       "network-ssd",
+	},
+	KafkaConfig: &kafka.ConfigSpec_Kafka_KafkaConfig_3{
+		KafkaConfig_3: &kafka.KafkaConfig3{
+			CompressionType: kafka.CompressionType_COMPRESSION_TYPE_GZIP,
+			NumPartitions:   wrapperspb.Int64(3),
+			LogPreallocate:  wrapperspb.Bool(false),
+			SaslEnabledMechanisms: []kafka.SaslMechanism{
+				kafka.SaslMechanism_SASL_MECHANISM_SCRAM_SHA_512,
+			},
+		},
+	},
+}
+
+func TestYandexProvider_MDBKafkaClusterKafkaFlatten(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+
+	resources := buildTestResourcesObj("s2.micro", 16, "network-ssd")
+	stateConfig := NewKafkaSettingsMapValueMust(map[string]attr.Value{
+		"num_partitions": types.Int64Value(3),
+	})
+
+	cases := []struct {
+		testname    string
+		state       types.Object
+		api         *kafka.ConfigSpec_Kafka
+		expectedVal types.Object
+	}{
+		{
+			testname: "CheckConfigFromApi",
+			state:    types.ObjectNull(KafkaAttrTypes),
+			api:      testKafkaSpec,
+			expectedVal: buildTestKafkaObj(
+				resources,
+				NewKafkaSettingsMapValueMust(map[string]attr.Value{
+					"compression_type": types.Int64Value(int64(kafka.CompressionType_COMPRESSION_TYPE_GZIP)),
+					"num_partitions":   types.Int64Value(3),
+					"log_preallocate":  types.BoolValue(false),
+					"sasl_enabled_mechanisms": types.TupleValueMust(
+						[]attr.Type{types.Int64Type},
+						[]attr.Value{types.Int64Value(int64(kafka.SaslMechanism_SASL_MECHANISM_SCRAM_SHA_512))},
+					),
+				}),
+			),
+		},
+		{
+			testname:    "CheckConfigFromState",
+			state:       buildTestKafkaObj(resources, stateConfig),
+			api:         testKafkaSpec,
+			expectedVal: buildTestKafkaObj(resources, stateConfig),
+		},
+		{
+			testname: "CheckChangedConfigFromApi",
+			state: buildTestKafkaObj(resources, NewKafkaSettingsMapValueMust(map[string]attr.Value{
+				"num_partitions":  types.Int64Value(5),
+				"log_preallocate": types.BoolValue(false),
+			})),
+			api: testKafkaSpec,
+			expectedVal: buildTestKafkaObj(resources, NewKafkaSettingsMapValueMust(map[string]attr.Value{
+				"num_partitions":  types.Int64Value(3),
+				"log_preallocate": types.BoolValue(false),
+			})),
+		},
+		{
+			testname:    "CheckNilKafka",
+			state:       types.ObjectNull(KafkaAttrTypes),
+			api:         nil,
+			expectedVal: types.ObjectNull(KafkaAttrTypes),
+		},
+	}
+
+	for _, c := range cases {
+		diags := diag.Diagnostics{}
+		res := flattenKafka(ctx, c.state, c.api, &diags)
+		if diags.HasError() {
+			t.Errorf("Unexpected flatten diagnostics status %s test: %v", c.testname, diags.Errors())
+			continue
+		}
+
+		if !c.expectedVal.Equal(res) {
+			t.Errorf(
+				"Unexpected flatten result value %s test: expected %s, actual %s",
+				c.testname,
+				c.expectedVal,
+				res,
+			)
+		}
+	}
+}
+
+func TestYandexProvider_MDBKafkaClusterConfigFlatten(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+
+	diags := diag.Diagnostics{}
+	cfg := flattenConfig(ctx, types.ObjectNull(KafkaAttrTypes), &kafka.ConfigSpec{
+		Version:        "3.6",
+		ZoneId:         []string{"ru-central1-a"},
+		BrokersCount:   wrapperspb.Int64(1),
+		AssignPublicIp: true,
+		Access:         &kafka.Access{DataTransfer: true},
+		KafkaUiConfig:  &kafka.ConfigSpec_KafkaUIConfig{Enabled: true},
+		Kafka:          testKafkaSpec,
+		Zookeeper: &kafka.ConfigSpec_Zookeeper{
+			Resources: &kafka.Resources{
+				ResourcePresetId: "s2.micro",
+				DiskSize:         datasize.ToBytes(10),
+				DiskTypeId:       "network-ssd",
+			},
+		},
+	}, &diags)
+	if diags.HasError() {
+		t.Fatalf("Unexpected flatten diagnostics: %v", diags.Errors())
+	}
+
+	checks := map[string][2]attr.Value{
+		"version":          {types.StringValue("3.6"), cfg.Version},
+		"zones":            {types.ListValueMust(types.StringType, []attr.Value{types.StringValue("ru-central1-a")}), cfg.Zones},
+		"brokers_count":    {types.Int64Value(1), cfg.BrokersCount},
+		"assign_public_ip": {types.BoolValue(true), cfg.AssignPublicIp},
+		"schema_registry":  {types.BoolValue(false), cfg.SchemaRegistry},
+		"access": {types.ObjectValueMust(AccessAttrTypes, map[string]attr.Value{
+			"data_transfer": types.BoolValue(true),
+		}), cfg.Access},
+		"rest_api": {types.ObjectValueMust(EnabledAttrTypes, map[string]attr.Value{
+			"enabled": types.BoolValue(false),
+		}), cfg.RestAPI},
+		"kafka_ui": {types.ObjectValueMust(EnabledAttrTypes, map[string]attr.Value{
+			"enabled": types.BoolValue(true),
+		}), cfg.KafkaUI},
+		"disk_size_autoscaling": {types.ObjectNull(DiskSizeAutoscalingAttrTypes), cfg.DiskSizeAutoscaling},
+		"zookeeper":             {buildTestSubclusterObj(buildTestResourcesObj("s2.micro", 10, "network-ssd")), cfg.Zookeeper},
+		"kraft":                 {types.ObjectNull(SubclusterAttrTypes), cfg.Kraft},
+	}
+
+	for name, c := range checks {
+		if !c[0].Equal(c[1]) {
+			t.Errorf("Unexpected flatten result value of %s: expected %s, actual %s", name, c[0], c[1])
+		}
+	}
+}
+
+func TestYandexProvider_MDBKafkaClusterHostsSubnetIdsFlatten(t *testing.T) {
+	t.Parallel()
+
+	hosts := []*kafka.Host{
+		{Name: "kafka-a", SubnetId: "subnet-a"},
+		{Name: "zk-b", SubnetId: "subnet-b"},
+		{Name: "zk-a", SubnetId: "subnet-a"},
+		{Name: "kafka-c"},
+	}
+
+	expected := []string{"subnet-a", "subnet-b"}
+	res := flattenHostsSubnetIds(hosts)
+	if !reflect.DeepEqual(expected, res) {
+		t.Errorf("Unexpected flatten result value: expected %v, actual %v", expected, res)
+	}
+
+	if res := flattenHostsSubnetIds(nil); len(res) != 0 {
+		t.Errorf("Unexpected flatten result value for no hosts: %v", res)
+	}
+}
@@ -0,0 +1,62 @@
+package mdb_kafka_cluster_v2
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/yandex-cloud/go-genproto/yandex/cloud/mdb/kafka/v1"
+	"github.com/yandex-cloud/terraform-provider-yandex/pkg/mdbcommon"
+)
+
+type KafkaSettingsAttributeInfoProvider struct{}
+
+func (p *KafkaSettingsAttributeInfoProvider) GetSettingsEnumNames() map[string]map[int32]string {
+	return kafkaSettingsEnumNames
+}
+
+func (p *KafkaSettingsAttributeInfoProvider) GetSettingsEnumValues() map[string]map[string]int32 {
+	return kafkaSettingsEnumValues
+}
+
+func (p *KafkaSettingsAttributeInfoProvider) GetSetAttributes() map[string]struct{} {
+	return listAttributes
+}
+
+var kafkaSettingsEnumNames = map[string]map[int32]string{
+	"compression_type":                kafka.CompressionType_name,
+	"sasl_enabled_mechanisms.element": kafka.SaslMechanism_name,
+}
+
+var kafkaSettingsEnumValues = map[string]map[string]int32{
+	"compression_type":                kafka.CompressionType_value,
+	"sasl_enabled_mechanisms.element": kafka.SaslMechanism_value,
+}
+
+var listAttributes = map[string]struct{}{
+	"ssl_cipher_suites":       {},
+	"sasl_enabled_mechanisms": {},
+}
+
+var kafkaAttrProvider = &KafkaSettingsAttributeInfoProvider{}
+
+func NewKafkaSettingsMapType() mdbcommon.SettingsMapType {
+	return mdbcommon.NewSettingsMapType(kafkaAttrProvider)
+}
+
+func NewKafkaSettingsMapValue(elements map[string]attr.Value) (mdbcommon.SettingsMapValue, diag.Diagnostics) {
+	return mdbcommon.NewSettingsMapValue(elements, kafkaAttrProvider)
+}
+
+func NewKafkaSettingsMapValueMust(elements map[string]attr.Value) mdbcommon.SettingsMapValue {
+	val, d := NewKafkaSettingsMapValue(elements)
+	if d.HasError() {
+		panic(fmt.Sprintf("%v", d))
+	}
+
+	return val
+}
+
+func NewKafkaSettingsMapNull() mdbcommon.SettingsMapValue {
+	return mdbcommon.NewSettingsMapNull()
+}
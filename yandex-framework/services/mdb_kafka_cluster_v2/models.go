@@ -0,0 +1,122 @@
+package mdb_kafka_cluster_v2
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/yandex-cloud/terraform-provider-yandex/pkg/mdbcommon"
+)
+
+type Cluster struct {
+	Id                  types.String   `tfsdk:"id"`
+	FolderId            types.String   `tfsdk:"folder_id"`
+	NetworkId           types.String   `tfsdk:"network_id"`
+	Name                types.String   `tfsdk:"name"`
+	Description         types.String   `tfsdk:"description"`
+	Environment         types.String   `tfsdk:"environment"`
+	Labels              types.Map      `tfsdk:"labels"`
+	SubnetIds           types.Set      `tfsdk:"subnet_ids"`
+	SecurityGroupIds    types.Set      `tfsdk:"security_group_ids"`
+	HostGroupIds        types.Set      `tfsdk:"host_group_ids"`
+	DeletionProtection  types.Bool     `tfsdk:"deletion_protection"`
+	MaintenanceWindow   types.Object   `tfsdk:"maintenance_window"`
+	Version             types.String   `tfsdk:"version"`
+	Zones               types.List     `tfsdk:"zones"`
+	BrokersCount        types.Int64    `tfsdk:"brokers_count"`
+	AssignPublicIp      types.Bool     `tfsdk:"assign_public_ip"`
+	SchemaRegistry      types.Bool     `tfsdk:"schema_registry"`
+	Access              types.Object   `tfsdk:"access"`
+	RestAPI             types.Object   `tfsdk:"rest_api"`
+	KafkaUI             types.Object   `tfsdk:"kafka_ui"`
+	DiskSizeAutoscaling types.Object   `tfsdk:"disk_size_autoscaling"`
+	Kafka               types.Object   `tfsdk:"kafka"`
+	Zookeeper           types.Object   `tfsdk:"zookeeper"`
+	Kraft               types.Object   `tfsdk:"kraft"`
+	Timeouts            timeouts.Value `tfsdk:"timeouts"`
+}
+
+type Config struct {
+	Version             types.String `tfsdk:"version"`
+	Zones               types.List   `tfsdk:"zones"`
+	BrokersCount        types.Int64  `tfsdk:"brokers_count"`
+	AssignPublicIp      types.Bool   `tfsdk:"assign_public_ip"`
+	SchemaRegistry      types.Bool   `tfsdk:"schema_registry"`
+	Access              types.Object `tfsdk:"access"`
+	RestAPI             types.Object `tfsdk:"rest_api"`
+	KafkaUI             types.Object `tfsdk:"kafka_ui"`
+	DiskSizeAutoscaling types.Object `tfsdk:"disk_size_autoscaling"`
+	Kafka               types.Object `tfsdk:"kafka"`
+	Zookeeper           types.Object `tfsdk:"zookeeper"`
+	Kraft               types.Object `tfsdk:"kraft"`
+}
+
+type Kafka struct {
+	Resources   types.Object               `tfsdk:"resources"`
+	KafkaConfig mdbcommon.SettingsMapValue `tfsdk:"kafka_config"`
+}
+
+var KafkaAttrTypes = map[string]attr.Type{
+	"resources":    types.ObjectType{AttrTypes: ResourcesAttrTypes},
+	"kafka_config": mdbcommon.NewSettingsMapType(kafkaAttrProvider),
+}
+
+// Zookeeper and KRaft subclusters are described only by their resources
+type Subcluster struct {
+	Resources types.Object `tfsdk:"resources"`
+}
+
+var SubclusterAttrTypes = map[string]attr.Type{
+	"resources": types.ObjectType{AttrTypes: ResourcesAttrTypes},
+}
+
+type Resources struct {
+	ResourcePresetID types.String `tfsdk:"resource_preset_id"`
+	DiskSize         types.Int64  `tfsdk:"disk_size"`
+	DiskTypeID       types.String `tfsdk:"disk_type_id"`
+}
+
+var ResourcesAttrTypes = map[string]attr.Type{
+	"resource_preset_id": types.StringType,
+	"disk_size":          types.Int64Type,
+	"disk_type_id":       types.StringType,
+}
+
+type Access struct {
+	DataTransfer types.Bool `tfsdk:"data_transfer"`
+}
+
+var AccessAttrTypes = map[string]attr.Type{
+	"data_transfer": types.BoolType,
+}
+
+type Enabled struct {
+	Enabled types.Bool `tfsdk:"enabled"`
+}
+
+var EnabledAttrTypes = map[string]attr.Type{
+	"enabled": types.BoolType,
+}
+
+type DiskSizeAutoscaling struct {
+	DiskSizeLimit           types.Int64 `tfsdk:"disk_size_limit"`
+	PlannedUsageThreshold   types.Int64 `tfsdk:"planned_usage_threshold"`
+	EmergencyUsageThreshold types.Int64 `tfsdk:"emergency_usage_threshold"`
+}
+
+var DiskSizeAutoscalingAttrTypes = map[string]attr.Type{
+	"disk_size_limit":           types.Int64Type,
+	"planned_usage_threshold":   types.Int64Type,
+	"emergency_usage_threshold": types.Int64Type,
+}
+
+type MaintenanceWindow struct {
+	Type types.String `tfsdk:"type"`
+	Day  types.String `tfsdk:"day"`
+	Hour types.Int64  `tfsdk:"hour"`
+}
+
+var MaintenanceWindowAttrTypes = map[string]attr.Type{
+	"type": types.StringType,
+	"day":  types.StringType,
+	"hour": types.Int64Type,
+}
@@ -0,0 +1,153 @@
+package mdb_kafka_cluster_v2
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/yandex-cloud/terraform-provider-yandex/pkg/mdbcommon"
+)
+
+const kafkaClusterV1TypeName = "yandex_mdb_kafka_cluster"
+
+var _ resource.ResourceWithMoveState = &clusterResource{}
+
+// v1ClusterState is the part of the yandex_mdb_kafka_cluster state needed to move it.
+// Everything else is read from the API by the refresh that follows the move.
+type v1ClusterState struct {
+	Id          string `json:"id"`
+	FolderId    string `json:"folder_id"`
+	NetworkId   string `json:"network_id"`
+	Name        string `json:"name"`
+	Description string `json:"description"`
+	Environment string `json:"environment"`
+	Config      []struct {
+		Kafka []struct {
+			Resources []struct {
+				ResourcePresetId string `json:"resource_preset_id"`
+				DiskSize         int64  `json:"disk_size"`
+				DiskTypeId       string `json:"disk_type_id"`
+			} `json:"resources"`
+			KafkaConfig []map[string]any `json:"kafka_config"`
+		} `json:"kafka"`
+	} `json:"config"`
+}
+
+// MoveState allows to move a cluster from yandex_mdb_kafka_cluster with the `moved` block
+// without recreation.
+func (r *clusterResource) MoveState(ctx context.Context) []resource.StateMover {
+	return []resource.StateMover{
+		{
+			StateMover: moveStateFromV1,
+		},
+	}
+}
+
+func moveStateFromV1(ctx context.Context, req resource.MoveStateRequest, resp *resource.MoveStateResponse) {
+	if req.SourceTypeName != kafkaClusterV1TypeName {
+		return
+	}
+
+	var src v1ClusterState
+	dec := json.NewDecoder(bytes.NewReader(req.SourceRawState.JSON))
+	dec.UseNumber()
+	if err := dec.Decode(&src); err != nil {
+		resp.Diagnostics.AddError(
+			"Failed to move Kafka cluster state",
+			fmt.Sprintf("Error while parsing state of %s: %s", kafkaClusterV1TypeName, err),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.TargetState.SetAttribute(ctx, path.Root("id"), src.Id)...)
+	resp.Diagnostics.Append(resp.TargetState.SetAttribute(ctx, path.Root("folder_id"), src.FolderId)...)
+	resp.Diagnostics.Append(resp.TargetState.SetAttribute(ctx, path.Root("network_id"), src.NetworkId)...)
+	resp.Diagnostics.Append(resp.TargetState.SetAttribute(ctx, path.Root("name"), src.Name)...)
+	resp.Diagnostics.Append(resp.TargetState.SetAttribute(ctx, path.Root("description"), src.Description)...)
+	resp.Diagnostics.Append(resp.TargetState.SetAttribute(ctx, path.Root("environment"), src.Environment)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if len(src.Config) == 0 || len(src.Config[0].Kafka) == 0 {
+		return
+	}
+	k := src.Config[0].Kafka[0]
+
+	resources := types.ObjectNull(ResourcesAttrTypes)
+	if len(k.Resources) > 0 {
+		obj, d := types.ObjectValueFrom(ctx, ResourcesAttrTypes, Resources{
+			ResourcePresetID: types.StringValue(k.Resources[0].ResourcePresetId),
+			DiskSize:         types.Int64Value(k.Resources[0].DiskSize),
+			DiskTypeID:       types.StringValue(k.Resources[0].DiskTypeId),
+		})
+		resp.Diagnostics.Append(d...)
+		resources = obj
+	}
+
+	kafkaConfig := NewKafkaSettingsMapNull()
+	if len(k.KafkaConfig) > 0 {
+		mv, d := types.MapValue(types.StringType, moveKafkaConfigFromV1(k.KafkaConfig[0]))
+		resp.Diagnostics.Append(d...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		v, d := NewKafkaSettingsMapType().ValueFromMap(ctx, mv)
+		resp.Diagnostics.Append(d...)
+		kafkaConfig = v.(mdbcommon.SettingsMapValue)
+	}
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	kafkaObj, d := types.ObjectValueFrom(ctx, KafkaAttrTypes, Kafka{
+		Resources:   resources,
+		KafkaConfig: kafkaConfig,
+	})
+	resp.Diagnostics.Append(d...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.TargetState.SetAttribute(ctx, path.Root("kafka"), kafkaObj)...)
+}
+
+// moveKafkaConfigFromV1 converts the kafka_config block of yandex_mdb_kafka_cluster to the settings map.
+// The v1 state keeps zero values of settings not defined by user, they are skipped like in flattenKafkaConfig.
+func moveKafkaConfigFromV1(src map[string]any) map[string]attr.Value {
+	attrs := make(map[string]attr.Value)
+	for key, val := range src {
+		var s string
+		switch v := val.(type) {
+		case string:
+			s = v
+		case json.Number:
+			if v.String() != "0" {
+				s = v.String()
+			}
+		case bool:
+			if v {
+				s = "true"
+			}
+		case []any:
+			els := make([]string, 0, len(v))
+			for _, el := range v {
+				els = append(els, fmt.Sprint(el))
+			}
+			sort.Strings(els)
+			s = strings.Join(els, ",")
+		}
+
+		if s != "" {
+			attrs[key] = types.StringValue(s)
+		}
+	}
+	return attrs
+}
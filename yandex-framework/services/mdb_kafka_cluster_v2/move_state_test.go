@@ -0,0 +1,130 @@
+package mdb_kafka_cluster_v2
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/yandex-cloud/terraform-provider-yandex/pkg/datasize"
+)
+
+const testKafkaClusterV1State = `{
+  "id": "cid",
+  "folder_id": "fid",
+  "network_id": "nid",
+  "name": "kafka",
+  "description": "",
+  "environment": "PRODUCTION",
+  "deletion_protection": false,
+  "config": [{
+    "version": "3.6",
+    "zones": ["ru-central1-a"],
+    "kafka": [{
+      "resources": [{
+        "resource_preset_id": "s2.micro",
+        "disk_type_id": "network-ssd",
+        "disk_size": 16
+      }],
+      "kafka_config": [{
+        "compression_type": "COMPRESSION_TYPE_ZSTD",
+        "num_partitions": "3",
+        "log_retention_bytes": "",
+        "log_preallocate": false,
+        "auto_create_topics_enable": true,
+        "sasl_enabled_mechanisms": ["SASL_MECHANISM_SCRAM_SHA_512", "SASL_MECHANISM_SCRAM_SHA_256"],
+        "ssl_cipher_suites": []
+      }]
+    }]
+  }]
+}`
+
+func testMoveStateResponse(ctx context.Context, t *testing.T) *resource.MoveStateResponse {
+	schemaResp := resource.SchemaResponse{}
+	NewKafkaClusterResourceV2().Schema(ctx, resource.SchemaRequest{}, &schemaResp)
+	if schemaResp.Diagnostics.HasError() {
+		t.Fatalf("Unexpected schema diagnostics: %v", schemaResp.Diagnostics)
+	}
+
+	return &resource.MoveStateResponse{
+		TargetState: tfsdk.State{
+			Schema: schemaResp.Schema,
+			Raw:    tftypes.NewValue(schemaResp.Schema.Type().TerraformType(ctx), nil),
+		},
+	}
+}
+
+func TestYandexProvider_MDBKafkaClusterMoveStateFromV1(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	req := resource.MoveStateRequest{
+		SourceTypeName: "yandex_mdb_kafka_cluster",
+		SourceRawState: &tfprotov6.RawState{JSON: []byte(testKafkaClusterV1State)},
+	}
+	resp := testMoveStateResponse(ctx, t)
+
+	moveStateFromV1(ctx, req, resp)
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("Unexpected move state diagnostics: %v", resp.Diagnostics)
+	}
+
+	var state Cluster
+	if d := resp.TargetState.Get(ctx, &state); d.HasError() {
+		t.Fatalf("Unexpected state diagnostics: %v", d)
+	}
+
+	if state.Id.ValueString() != "cid" || state.FolderId.ValueString() != "fid" ||
+		state.NetworkId.ValueString() != "nid" || state.Name.ValueString() != "kafka" ||
+		state.Environment.ValueString() != "PRODUCTION" {
+		t.Errorf("Unexpected cluster attributes after move: %+v", state)
+	}
+
+	var k Kafka
+	if d := state.Kafka.As(ctx, &k, datasize.UnhandledOpts); d.HasError() {
+		t.Fatalf("Unexpected kafka diagnostics: %v", d)
+	}
+
+	expectedResources := types.ObjectValueMust(ResourcesAttrTypes, map[string]attr.Value{
+		"resource_preset_id": types.StringValue("s2.micro"),
+		"disk_size":          types.Int64Value(16),
+		"disk_type_id":       types.StringValue("network-ssd"),
+	})
+	if !k.Resources.Equal(expectedResources) {
+		t.Errorf("Unexpected kafka resources after move: expected %v, actual %v", expectedResources, k.Resources)
+	}
+
+	expectedConfig := types.MapValueMust(types.StringType, map[string]attr.Value{
+		"compression_type":          types.StringValue("COMPRESSION_TYPE_ZSTD"),
+		"num_partitions":            types.StringValue("3"),
+		"auto_create_topics_enable": types.StringValue("true"),
+		"sasl_enabled_mechanisms":   types.StringValue("SASL_MECHANISM_SCRAM_SHA_256,SASL_MECHANISM_SCRAM_SHA_512"),
+	})
+	if !k.KafkaConfig.MapValue.Equal(expectedConfig) {
+		t.Errorf("Unexpected kafka_config after move: expected %v, actual %v", expectedConfig, k.KafkaConfig)
+	}
+}
+
+func TestYandexProvider_MDBKafkaClusterMoveStateFromOtherType(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	req := resource.MoveStateRequest{
+		SourceTypeName: "yandex_mdb_mysql_cluster",
+		SourceRawState: &tfprotov6.RawState{JSON: []byte(testKafkaClusterV1State)},
+	}
+	resp := testMoveStateResponse(ctx, t)
+
+	moveStateFromV1(ctx, req, resp)
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("Unexpected move state diagnostics: %v", resp.Diagnostics)
+	}
+
+	if !resp.TargetState.Raw.IsNull() {
+		t.Errorf("Expected state of other resource type not to be moved, got %v", resp.TargetState.Raw)
+	}
+}
@@ -0,0 +1,518 @@
+package mdb_kafka_cluster_v2
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/objectvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/mapplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/objectdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/objectplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/setplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/yandex-cloud/go-genproto/yandex/cloud/mdb/kafka/v1"
+	"github.com/yandex-cloud/terraform-provider-yandex/common"
+	"github.com/yandex-cloud/terraform-provider-yandex/common/defaultschema"
+	"github.com/yandex-cloud/terraform-provider-yandex/pkg/mdbcommon"
+	provider_config "github.com/yandex-cloud/terraform-provider-yandex/yandex-framework/provider/config"
+)
+
+const (
+	yandexMDBKafkaClusterDefaultTimeout = 60 * time.Minute
+	yandexMDBKafkaClusterUpdateTimeout  = 90 * time.Minute
+)
+
+type clusterResource struct {
+	providerConfig *provider_config.Config
+}
+
+func NewKafkaClusterResourceV2() resource.Resource {
+	return &clusterResource{}
+}
+
+func (r *clusterResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_mdb_kafka_cluster_v2"
+}
+
+func (r *clusterResource) Configure(_ context.Context,
+	req resource.ConfigureRequest, resp *resource.ConfigureResponse,
+) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerConfig, ok := req.ProviderData.(*provider_config.Config)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *provider_config.Config, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.providerConfig = providerConfig
+}
+
+func resourcesSchema(description string) schema.SingleNestedAttribute {
+	return schema.SingleNestedAttribute{
+		Description: description,
+		Required:    true,
+		Attributes: map[string]schema.Attribute{
+			"resource_preset_id": schema.StringAttribute{
+				Description: "The ID of the preset for computational resources available to a host (CPU, memory etc.). For more information, see [the official documentation](https://yandex.cloud/docs/managed-kafka/concepts).",
+				Required:    true,
+			},
+			"disk_type_id": schema.StringAttribute{
+				Description: "Type of the storage of hosts. For more information see [the official documentation](https://yandex.cloud/docs/managed-kafka/concepts/storage).",
+				Required:    true,
+			},
+			"disk_size": schema.Int64Attribute{
+				Description: "Volume of the storage available to a host, in gigabytes.",
+				Required:    true,
+			},
+		},
+	}
+}
+
+func enabledSchema(description, enabledDescription string) schema.SingleNestedAttribute {
+	return schema.SingleNestedAttribute{
+		Description: description,
+		Optional:    true,
+		Computed:    true,
+		Default: objectdefault.StaticValue(types.ObjectValueMust(EnabledAttrTypes, map[string]attr.Value{
+			"enabled": types.BoolValue(false),
+		})),
+		Attributes: map[string]schema.Attribute{
+			"enabled": schema.BoolAttribute{
+				Description: enabledDescription,
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(false),
+			},
+		},
+	}
+}
+
+func (r *clusterResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages a Kafka cluster within the Yandex Cloud. For more information, see [the official documentation](https://yandex.cloud/docs/managed-kafka/concepts).",
+		Attributes: map[string]schema.Attribute{
+			"timeouts": timeouts.Attributes(ctx, timeouts.Opts{
+				Create: true,
+				Update: true,
+				Delete: true,
+			}),
+			"id": schema.StringAttribute{
+				Description: common.ResourceDescriptions["id"],
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Description: "Name of the Kafka cluster. Provided by the client when the cluster is created.",
+				Required:    true,
+			},
+			"description": schema.StringAttribute{
+				Description: "Description of the Kafka cluster.",
+				Computed:    true,
+				Default:     stringdefault.StaticString(""),
+				Optional:    true,
+			},
+			"folder_id":  defaultschema.FolderId(),
+			"network_id": defaultschema.NetworkId(),
+			"environment": schema.StringAttribute{
+				Description: "Deployment environment of the Kafka cluster. Can be either `PRESTABLE` or `PRODUCTION`. The default is `PRODUCTION`.",
+				Optional:    true,
+				Computed:    true,
+				Default:     stringdefault.StaticString("PRODUCTION"),
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.OneOfCaseInsensitive("PRESTABLE", "PRODUCTION"),
+				},
+			},
+			"labels": defaultschema.Labels(),
+			"subnet_ids": schema.SetAttribute{
+				Description: "IDs of the subnets, to which the Kafka cluster belongs.",
+				ElementType: types.StringType,
+				Optional:    true,
+				Computed:    true,
+				PlanModifiers: []planmodifier.Set{
+					setplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"security_group_ids": defaultschema.SecurityGroupIds(),
+			"host_group_ids": schema.SetAttribute{
+				Description: "A list of IDs of the host groups to place VMs of the cluster on.",
+				ElementType: types.StringType,
+				Optional:    true,
+				Computed:    true,
+				PlanModifiers: []planmodifier.Set{
+					setplanmodifier.UseStateForUnknown(),
+					setplanmodifier.RequiresReplace(),
+				},
+			},
+			"deletion_protection": defaultschema.DeletionProtection(),
+			"version": schema.StringAttribute{
+				Description: "Version of the Kafka server software.",
+				Required:    true,
+			},
+			"zones": schema.ListAttribute{
+				Description: "List of availability zones.",
+				ElementType: types.StringType,
+				Required:    true,
+				Validators: []validator.List{
+					listvalidator.SizeAtLeast(1),
+				},
+			},
+			"brokers_count": schema.Int64Attribute{
+				Description: "Count of brokers per availability zone. The default is `1`.",
+				Optional:    true,
+				Computed:    true,
+				Default:     int64default.StaticInt64(1),
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
+			"assign_public_ip": schema.BoolAttribute{
+				Description: "Determines whether each broker will be assigned a public IP address. The default is `false`.",
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(false),
+			},
+			"schema_registry": schema.BoolAttribute{
+				Description: "Enables managed schema registry on cluster. The default is `false`.",
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(false),
+			},
+			"access": schema.SingleNestedAttribute{
+				Description: "Access policy to the Kafka cluster.",
+				Optional:    true,
+				Computed:    true,
+				PlanModifiers: []planmodifier.Object{
+					objectplanmodifier.UseStateForUnknown(),
+				},
+				Attributes: map[string]schema.Attribute{
+					"data_transfer": schema.BoolAttribute{
+						Description: "Allow access for DataTransfer.",
+						Optional:    true,
+						Computed:    true,
+						Default:     booldefault.StaticBool(false),
+					},
+				},
+			},
+			"rest_api": enabledSchema("REST API settings of the Kafka cluster.", "Enables REST API on cluster. The default is `false`."),
+			"kafka_ui": enabledSchema("Kafka UI settings of the Kafka cluster.", "Enables Kafka UI on cluster. The default is `false`."),
+			"disk_size_autoscaling": schema.SingleNestedAttribute{
+				Description: "Disk autoscaling settings of the Kafka cluster.",
+				Optional:    true,
+				Computed:    true,
+				PlanModifiers: []planmodifier.Object{
+					objectplanmodifier.UseStateForUnknown(),
+				},
+				Attributes: map[string]schema.Attribute{
+					"disk_size_limit": schema.Int64Attribute{
+						Description: "Maximum possible size of disk in gigabytes.",
+						Required:    true,
+						Validators: []validator.Int64{
+							mdbcommon.Int64GreaterValidator(path.MatchRoot("kafka").AtName("resources").AtName("disk_size")),
+						},
+					},
+					"planned_usage_threshold": schema.Int64Attribute{
+						Description: "Disk usage percentage threshold for scheduled autoscaling during the maintenance window. Zero value means disabled threshold.",
+						Optional:    true,
+						Computed:    true,
+						Default:     int64default.StaticInt64(0),
+						Validators: []validator.Int64{
+							int64validator.Between(0, 100),
+						},
+					},
+					"emergency_usage_threshold": schema.Int64Attribute{
+						Description: "Disk usage percentage threshold for immediate autoscaling. Zero value means disabled threshold.",
+						Optional:    true,
+						Computed:    true,
+						Default:     int64default.StaticInt64(0),
+						Validators: []validator.Int64{
+							int64validator.Any(
+								mdbcommon.Int64GreaterValidator(path.MatchRoot("disk_size_autoscaling").AtName("planned_usage_threshold")),
+								int64validator.OneOf(0),
+							),
+						},
+					},
+				},
+			},
+			"kafka": schema.SingleNestedAttribute{
+				Description: "Configuration of the Kafka brokers.",
+				Required:    true,
+				Attributes: map[string]schema.Attribute{
+					"resources": resourcesSchema("Resources allocated to hosts of the Kafka brokers."),
+					"kafka_config": schema.MapAttribute{
+						CustomType:  mdbcommon.NewSettingsMapType(kafkaAttrProvider),
+						Description: "Kafka broker settings, including defaults for topics created in the cluster (e.g. `num_partitions`, `default_replication_factor`). For more information, see [the official documentation](https://yandex.cloud/docs/managed-kafka/operations/cluster-update#change-kafka-settings). List values, such as `sasl_enabled_mechanisms` and `ssl_cipher_suites`, are comma-separated.",
+						Optional:    true,
+						Computed:    true,
+						PlanModifiers: []planmodifier.Map{
+							mapplanmodifier.UseStateForUnknown(),
+						},
+					},
+				},
+			},
+			"zookeeper": schema.SingleNestedAttribute{
+				Description: "Configuration of the ZooKeeper subcluster.",
+				Optional:    true,
+				Computed:    true,
+				PlanModifiers: []planmodifier.Object{
+					objectplanmodifier.UseStateForUnknown(),
+				},
+				Validators: []validator.Object{
+					objectvalidator.ConflictsWith(path.MatchRoot("kraft")),
+				},
+				Attributes: map[string]schema.Attribute{
+					"resources": resourcesSchema("Resources allocated to hosts of the ZooKeeper subcluster."),
+				},
+			},
+			"kraft": schema.SingleNestedAttribute{
+				Description: "Configuration of the KRaft controller subcluster.",
+				Optional:    true,
+				Computed:    true,
+				PlanModifiers: []planmodifier.Object{
+					objectplanmodifier.UseStateForUnknown(),
+				},
+				Attributes: map[string]schema.Attribute{
+					"resources": resourcesSchema("Resources allocated to hosts of the KRaft controller subcluster."),
+				},
+			},
+			// Optional nested attribute maintenance_window required all optional nested attributes
+			// But if the block is specified explicitly, then the type attribute is required
+			"maintenance_window": schema.SingleNestedAttribute{
+				Description: "Maintenance policy of the Kafka cluster.",
+				Optional:    true,
+				Computed:    true,
+				PlanModifiers: []planmodifier.Object{
+					objectplanmodifier.UseStateForUnknown(),
+				},
+				Validators: []validator.Object{
+					NewMaintenanceWindowStructValidator(),
+				},
+				Attributes: map[string]schema.Attribute{
+					"type": schema.StringAttribute{
+						Description: "Type of maintenance window. Can be either ANYTIME or WEEKLY. A day and hour of window need to be specified with weekly window.",
+						Optional:    true,
+						Validators: []validator.String{
+							stringvalidator.OneOf("ANYTIME", "WEEKLY"),
+						},
+					},
+					"day": schema.StringAttribute{
+						Description: "Day of the week (in DDD format). Allowed values: \"MON\", \"TUE\", \"WED\", \"THU\", \"FRI\", \"SAT\",\"SUN\"",
+						Optional:    true,
+						Validators: []validator.String{
+							stringvalidator.OneOf(
+								"MON", "TUE",
+								"WED", "THU",
+								"FRI", "SAT",
+								"SUN",
+							),
+						},
+					},
+					"hour": schema.Int64Attribute{
+						Description: "Hour of the day in UTC (in HH format). Allowed value is between 1 and 24.",
+						Optional:    true,
+						Validators: []validator.Int64{
+							int64validator.Between(1, 24),
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (r *clusterResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state Cluster
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	r.refreshResourceState(ctx, &state, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	d := resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(d...)
+}
+
+func (r *clusterResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan Cluster
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	createTimeout, diags := plan.Timeouts.Create(ctx, yandexMDBKafkaClusterDefaultTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
+
+	tflog.Debug(ctx, "Creating Kafka Cluster")
+
+	request, diags := prepareCreateRequest(ctx, &plan, &r.providerConfig.ProviderState)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	cid := kafkaApi.CreateCluster(ctx, r.providerConfig.SDK, &resp.Diagnostics, request)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	plan.Id = types.StringValue(cid)
+
+	r.refreshResourceState(ctx, &plan, &resp.Diagnostics)
+	diags = resp.State.Set(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *clusterResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan Cluster
+	var state Cluster
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	updateTimeout, diags := plan.Timeouts.Update(ctx, yandexMDBKafkaClusterUpdateTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, updateTimeout)
+	defer cancel()
+
+	tflog.Debug(ctx, "Updating Kafka Cluster", map[string]any{"id": plan.Id.ValueString()})
+	tflog.Debug(ctx, fmt.Sprintf("Update Kafka Cluster state: %+v", state))
+	tflog.Debug(ctx, fmt.Sprintf("Update Kafka Cluster plan: %+v", plan))
+
+	updateVersionRequest, d := prepareVersionUpdateRequest(&state, &plan)
+	resp.Diagnostics.Append(d...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	kafkaApi.UpdateCluster(ctx, r.providerConfig.SDK, &resp.Diagnostics, updateVersionRequest)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	updateRequest, d := prepareUpdateRequest(ctx, &state, &plan)
+	resp.Diagnostics.Append(d...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	kafkaApi.UpdateCluster(ctx, r.providerConfig.SDK, &resp.Diagnostics, updateRequest)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	r.refreshResourceState(ctx, &plan, &resp.Diagnostics)
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *clusterResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state Cluster
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	deleteTimeout, diags := state.Timeouts.Delete(ctx, yandexMDBKafkaClusterDefaultTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, deleteTimeout)
+	defer cancel()
+
+	cid := state.Id.ValueString()
+	kafkaApi.DeleteCluster(ctx, r.providerConfig.SDK, &resp.Diagnostics, cid)
+}
+
+func (r *clusterResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+func (r *clusterResource) refreshResourceState(ctx context.Context, state *Cluster, respDiagnostics *diag.Diagnostics) {
+	cid := state.Id.ValueString()
+	cluster := kafkaApi.GetCluster(ctx, r.providerConfig.SDK, respDiagnostics, cid)
+	if respDiagnostics.HasError() {
+		return
+	}
+
+	state.Id = types.StringValue(cluster.Id)
+	state.FolderId = types.StringValue(cluster.FolderId)
+	state.NetworkId = types.StringValue(cluster.NetworkId)
+	state.Name = types.StringValue(cluster.Name)
+	state.Description = types.StringValue(cluster.Description)
+	state.Environment = mdbcommon.FlattenEnvironment(ctx, state.Environment, cluster.GetEnvironment().String(), respDiagnostics)
+	state.Labels = mdbcommon.FlattenMapString(ctx, cluster.Labels, respDiagnostics)
+	state.DeletionProtection = types.BoolValue(cluster.GetDeletionProtection())
+	state.MaintenanceWindow = mdbcommon.FlattenMaintenanceWindow[
+		kafka.MaintenanceWindow,
+		kafka.WeeklyMaintenanceWindow,
+		kafka.AnytimeMaintenanceWindow,
+		kafka.WeeklyMaintenanceWindow_WeekDay,
+	](ctx, cluster.MaintenanceWindow, respDiagnostics)
+	state.SecurityGroupIds = mdbcommon.FlattenSetString(ctx, cluster.SecurityGroupIds, respDiagnostics)
+	state.HostGroupIds = mdbcommon.FlattenSetString(ctx, cluster.HostGroupIds, respDiagnostics)
+
+	hosts := kafkaApi.ListHosts(ctx, r.providerConfig.SDK, respDiagnostics, cid)
+	if respDiagnostics.HasError() {
+		return
+	}
+	state.SubnetIds = mdbcommon.FlattenSetString(ctx, flattenHostsSubnetIds(hosts), respDiagnostics)
+
+	cfg := flattenConfig(ctx, state.Kafka, cluster.GetConfig(), respDiagnostics)
+
+	state.Version = cfg.Version
+	state.Zones = cfg.Zones
+	state.BrokersCount = cfg.BrokersCount
+	state.AssignPublicIp = cfg.AssignPublicIp
+	state.SchemaRegistry = cfg.SchemaRegistry
+	state.Access = cfg.Access
+	state.RestAPI = cfg.RestAPI
+	state.KafkaUI = cfg.KafkaUI
+	state.DiskSizeAutoscaling = cfg.DiskSizeAutoscaling
+	state.Kafka = cfg.Kafka
+	state.Zookeeper = cfg.Zookeeper
+	state.Kraft = cfg.Kraft
+}
@@ -0,0 +1,543 @@
+package mdb_kafka_cluster_v2_test
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+	"github.com/yandex-cloud/go-genproto/yandex/cloud/mdb/kafka/v1"
+	"google.golang.org/genproto/protobuf/field_mask"
+
+	test "github.com/yandex-cloud/terraform-provider-yandex/pkg/testhelpers"
+	"github.com/yandex-cloud/terraform-provider-yandex/yandex-framework/provider"
+	"github.com/yandex-cloud/terraform-provider-yandex/yandex-framework/provider/config"
+)
+
+const (
+	defaultMDBPageSize                 = 1000
+	kfVersion                          = "3.6"
+	yandexMDBKafkaClusterDeleteTimeout = 30 * time.Minute
+)
+
+const kfVPCDependencies = `
+resource "yandex_vpc_network" "mdb-kafka-test-net" {}
+
+resource "yandex_vpc_subnet" "mdb-kafka-test-subnet-a" {
+  zone           = "ru-central1-a"
+  network_id     = yandex_vpc_network.mdb-kafka-test-net.id
+  v4_cidr_blocks = ["10.1.0.0/24"]
+}
+
+resource "yandex_vpc_subnet" "mdb-kafka-test-subnet-b" {
+  zone           = "ru-central1-b"
+  network_id     = yandex_vpc_network.mdb-kafka-test-net.id
+  v4_cidr_blocks = ["10.2.0.0/24"]
+}
+
+resource "yandex_vpc_subnet" "mdb-kafka-test-subnet-d" {
+  zone           = "ru-central1-d"
+  network_id     = yandex_vpc_network.mdb-kafka-test-net.id
+  v4_cidr_blocks = ["10.3.0.0/24"]
+}
+
+`
+
+func init() {
+	resource.AddTestSweepers("yandex_mdb_kafka_cluster_v2", &resource.Sweeper{
+		Name: "yandex_mdb_kafka_cluster_v2",
+		F:    testSweepMDBKafkaCluster,
+	})
+}
+
+// TestMain - add sweepers flag to the go test command
+// important for sweepers run.
+func TestMain(m *testing.M) {
+	resource.TestMain(m)
+}
+
+func testSweepMDBKafkaCluster(_ string) error {
+	conf, err := test.ConfigForSweepers()
+	if err != nil {
+		return fmt.Errorf("error getting client: %s", err)
+	}
+
+	resp, err := conf.SDK.MDB().Kafka().Cluster().List(context.Background(), &kafka.ListClustersRequest{
+		FolderId: conf.ProviderState.FolderID.ValueString(),
+		PageSize: defaultMDBPageSize,
+	})
+	if err != nil {
+		return fmt.Errorf("error getting Kafka clusters: %s", err)
+	}
+
+	result := &multierror.Error{}
+	for _, c := range resp.Clusters {
+		if !sweepMDBKafkaCluster(conf, c.Id) {
+			result = multierror.Append(result, fmt.Errorf("failed to sweep Kafka cluster %q", c.Id))
+		}
+	}
+
+	return result.ErrorOrNil()
+}
+
+func sweepMDBKafkaCluster(conf *config.Config, id string) bool {
+	return test.SweepWithRetry(sweepMDBKafkaClusterOnce, conf, "Kafka cluster", id)
+}
+
+func sweepMDBKafkaClusterOnce(conf *config.Config, id string) error {
+	ctx, cancel := context.WithTimeout(context.Background(), yandexMDBKafkaClusterDeleteTimeout)
+	defer cancel()
+
+	mask := field_mask.FieldMask{Paths: []string{"deletion_protection"}}
+
+	op, err := conf.SDK.MDB().Kafka().Cluster().Update(ctx, &kafka.UpdateClusterRequest{
+		ClusterId:          id,
+		DeletionProtection: false,
+		UpdateMask:         &mask,
+	})
+	err = test.HandleSweepOperation(ctx, conf, op, err)
+	if err != nil && !strings.EqualFold(test.ErrorMessage(err), "no changes detected") {
+		return err
+	}
+
+	op, err = conf.SDK.MDB().Kafka().Cluster().Delete(ctx, &kafka.DeleteClusterRequest{
+		ClusterId: id,
+	})
+	return test.HandleSweepOperation(ctx, conf, op, err)
+}
+
+func mdbKafkaClusterImportStep(name string) resource.TestStep {
+	return resource.TestStep{
+		ResourceName:      name,
+		ImportState:       true,
+		ImportStateVerify: true,
+		ImportStateVerifyIgnore: []string{
+			"timeouts",
+		},
+	}
+}
+
+// Test that a Kafka Cluster can be created, updated and destroyed
+func TestAccMDBKafkaClusterV2_basic(t *testing.T) {
+	t.Parallel()
+
+	var cluster *kafka.Cluster
+	clusterName := acctest.RandomWithPrefix("tf-kafka-v2-basic")
+	clusterResource := "yandex_mdb_kafka_cluster_v2.foo"
+	description := "Kafka Cluster Terraform Test Basic"
+	descriptionUpdated := fmt.Sprintf("%s Updated", description)
+	folderID := test.GetExampleFolderID()
+
+	kafkaConfig := `
+      compression_type        = "COMPRESSION_TYPE_ZSTD"
+      num_partitions          = 3
+      sasl_enabled_mechanisms = "SASL_MECHANISM_SCRAM_SHA_256,SASL_MECHANISM_SCRAM_SHA_512"
+	`
+	kafkaConfigUpdated := `
+      compression_type           = "COMPRESSION_TYPE_GZIP"
+      num_partitions             = 5
+      default_replication_factor = 1
+      log_retention_hours        = 48
+	`
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { test.AccPreCheck(t) },
+		ProtoV6ProviderFactories: test.AccProviderFactories,
+		CheckDestroy:             testAccCheckMDBKafkaClusterDestroy,
+		Steps: []resource.TestStep{
+			// Create Kafka Cluster
+			{
+				Config: testAccMDBKafkaClusterV2Basic(clusterName, description, kafkaConfig, 16, false),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(clusterResource, tfjsonpath.New("name"), knownvalue.StringExact(clusterName)),
+					statecheck.ExpectKnownValue(clusterResource, tfjsonpath.New("description"), knownvalue.StringExact(description)),
+					statecheck.ExpectKnownValue(clusterResource, tfjsonpath.New("folder_id"), knownvalue.StringExact(folderID)),
+					statecheck.ExpectKnownValue(clusterResource, tfjsonpath.New("environment"), knownvalue.StringExact("PRODUCTION")),
+					statecheck.ExpectKnownValue(clusterResource, tfjsonpath.New("version"), knownvalue.StringExact(kfVersion)),
+					statecheck.ExpectKnownValue(clusterResource, tfjsonpath.New("brokers_count"), knownvalue.Int64Exact(1)),
+					statecheck.ExpectKnownValue(clusterResource, tfjsonpath.New("deletion_protection"), knownvalue.Bool(false)),
+					statecheck.ExpectKnownValue(clusterResource, tfjsonpath.New("kafka").AtMapKey("resources"), knownvalue.ObjectExact(map[string]knownvalue.Check{
+						"resource_preset_id": knownvalue.StringExact("s2.micro"),
+						"disk_type_id":       knownvalue.StringExact("network-ssd"),
+						"disk_size":          knownvalue.Int64Exact(16),
+					})),
+					statecheck.ExpectKnownValue(clusterResource, tfjsonpath.New("kafka").AtMapKey("kafka_config"), knownvalue.MapExact(map[string]knownvalue.Check{
+						"compression_type":        knownvalue.StringExact("COMPRESSION_TYPE_ZSTD"),
+						"num_partitions":          knownvalue.StringExact("3"),
+						"sasl_enabled_mechanisms": knownvalue.StringExact("SASL_MECHANISM_SCRAM_SHA_256,SASL_MECHANISM_SCRAM_SHA_512"),
+					})),
+					statecheck.ExpectKnownValue(clusterResource, tfjsonpath.New("maintenance_window"), knownvalue.ObjectExact(map[string]knownvalue.Check{
+						"type": knownvalue.StringExact("ANYTIME"),
+						"day":  knownvalue.Null(),
+						"hour": knownvalue.Null(),
+					})),
+				},
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckExistsAndParseMDBKafkaCluster(clusterResource, &cluster),
+					testAccCheckMDBKafkaClusterKafkaConfig3(&cluster, func(c *kafka.KafkaConfig3) error {
+						if c.GetCompressionType() != kafka.CompressionType_COMPRESSION_TYPE_ZSTD {
+							return fmt.Errorf("unexpected compression_type: %s", c.GetCompressionType())
+						}
+						if c.GetNumPartitions().GetValue() != 3 {
+							return fmt.Errorf("unexpected num_partitions: %d", c.GetNumPartitions().GetValue())
+						}
+						if len(c.GetSaslEnabledMechanisms()) != 2 {
+							return fmt.Errorf("unexpected sasl_enabled_mechanisms: %v", c.GetSaslEnabledMechanisms())
+						}
+						return nil
+					}),
+				),
+			},
+			mdbKafkaClusterImportStep(clusterResource),
+			// Update Kafka Cluster description, broker settings, disk size and maintenance window
+			{
+				Config: testAccMDBKafkaClusterV2Basic(clusterName, descriptionUpdated, kafkaConfigUpdated, 20, true),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(clusterResource, plancheck.ResourceActionUpdate),
+					},
+				},
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(clusterResource, tfjsonpath.New("description"), knownvalue.StringExact(descriptionUpdated)),
+					statecheck.ExpectKnownValue(clusterResource, tfjsonpath.New("kafka").AtMapKey("resources").AtMapKey("disk_size"), knownvalue.Int64Exact(20)),
+					statecheck.ExpectKnownValue(clusterResource, tfjsonpath.New("kafka").AtMapKey("kafka_config"), knownvalue.MapExact(map[string]knownvalue.Check{
+						"compression_type":           knownvalue.StringExact("COMPRESSION_TYPE_GZIP"),
+						"num_partitions":             knownvalue.StringExact("5"),
+						"default_replication_factor": knownvalue.StringExact("1"),
+						"log_retention_hours":        knownvalue.StringExact("48"),
+					})),
+					statecheck.ExpectKnownValue(clusterResource, tfjsonpath.New("maintenance_window"), knownvalue.ObjectExact(map[string]knownvalue.Check{
+						"type": knownvalue.StringExact("WEEKLY"),
+						"day":  knownvalue.StringExact("MON"),
+						"hour": knownvalue.Int64Exact(5),
+					})),
+				},
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckExistsAndParseMDBKafkaCluster(clusterResource, &cluster),
+					testAccCheckMDBKafkaClusterKafkaConfig3(&cluster, func(c *kafka.KafkaConfig3) error {
+						if c.GetCompressionType() != kafka.CompressionType_COMPRESSION_TYPE_GZIP {
+							return fmt.Errorf("unexpected compression_type: %s", c.GetCompressionType())
+						}
+						if c.GetLogRetentionHours().GetValue() != 48 {
+							return fmt.Errorf("unexpected log_retention_hours: %d", c.GetLogRetentionHours().GetValue())
+						}
+						if len(c.GetSaslEnabledMechanisms()) != 0 {
+							return fmt.Errorf("sasl_enabled_mechanisms should be reset, got: %v", c.GetSaslEnabledMechanisms())
+						}
+						return nil
+					}),
+				),
+			},
+			mdbKafkaClusterImportStep(clusterResource),
+		},
+	})
+}
+
+// Test that a Kafka Cluster with KRaft controllers can be created
+func TestAccMDBKafkaClusterV2_kraft(t *testing.T) {
+	t.Parallel()
+
+	var cluster *kafka.Cluster
+	clusterName := acctest.RandomWithPrefix("tf-kafka-v2-kraft")
+	clusterResource := "yandex_mdb_kafka_cluster_v2.foo"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { test.AccPreCheck(t) },
+		ProtoV6ProviderFactories: test.AccProviderFactories,
+		CheckDestroy:             testAccCheckMDBKafkaClusterDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccMDBKafkaClusterV2Kraft(clusterName),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(clusterResource, tfjsonpath.New("zones"), knownvalue.ListSizeExact(3)),
+					statecheck.ExpectKnownValue(clusterResource, tfjsonpath.New("zookeeper"), knownvalue.Null()),
+					statecheck.ExpectKnownValue(clusterResource, tfjsonpath.New("kraft").AtMapKey("resources"), knownvalue.ObjectExact(map[string]knownvalue.Check{
+						"resource_preset_id": knownvalue.StringExact("s2.micro"),
+						"disk_type_id":       knownvalue.StringExact("network-ssd"),
+						"disk_size":          knownvalue.Int64Exact(10),
+					})),
+				},
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckExistsAndParseMDBKafkaCluster(clusterResource, &cluster),
+					func(s *terraform.State) error {
+						if cluster.GetConfig().GetKraft() == nil {
+							return fmt.Errorf("expected KRaft subcluster in Kafka cluster %s", cluster.GetId())
+						}
+						return nil
+					},
+				),
+			},
+			mdbKafkaClusterImportStep(clusterResource),
+		},
+	})
+}
+
+// Test that a cluster managed by yandex_mdb_kafka_cluster can be moved to yandex_mdb_kafka_cluster_v2
+// without recreation
+func TestAccMDBKafkaClusterV2_migrationFromV1(t *testing.T) {
+	t.Parallel()
+
+	var cluster *kafka.Cluster
+	clusterName := acctest.RandomWithPrefix("tf-kafka-v2-migration")
+	clusterResource := "yandex_mdb_kafka_cluster_v2.foo"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { test.AccPreCheck(t) },
+		ProtoV6ProviderFactories: test.AccProviderFactories,
+		CheckDestroy:             testAccCheckMDBKafkaClusterDestroy,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			// moved block between resource types is available since Terraform 1.8
+			tfversion.SkipBelow(tfversion.Version1_8_0),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testAccMDBKafkaClusterV1(clusterName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckExistsAndParseMDBKafkaCluster("yandex_mdb_kafka_cluster.foo", &cluster),
+				),
+			},
+			// Move the cluster to yandex_mdb_kafka_cluster_v2, the cluster must not be changed
+			{
+				Config: testAccMDBKafkaClusterV2Migrated(clusterName),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(clusterResource, plancheck.ResourceActionNoop),
+					},
+				},
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(clusterResource, tfjsonpath.New("name"), knownvalue.StringExact(clusterName)),
+					statecheck.ExpectKnownValue(clusterResource, tfjsonpath.New("kafka").AtMapKey("kafka_config"), knownvalue.MapExact(map[string]knownvalue.Check{
+						"compression_type": knownvalue.StringExact("COMPRESSION_TYPE_ZSTD"),
+						"num_partitions":   knownvalue.StringExact("3"),
+					})),
+				},
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckMDBKafkaClusterIdNotChanged(clusterResource, &cluster),
+				),
+			},
+			{
+				Config:   testAccMDBKafkaClusterV2Migrated(clusterName),
+				PlanOnly: true,
+			},
+		},
+	})
+}
+
+func testAccCheckMDBKafkaClusterDestroy(s *terraform.State) error {
+	config := test.AccProvider.(*provider.Provider).GetConfig()
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "yandex_mdb_kafka_cluster_v2" {
+			continue
+		}
+
+		_, err := config.SDK.MDB().Kafka().Cluster().Get(context.Background(), &kafka.GetClusterRequest{
+			ClusterId: rs.Primary.ID,
+		})
+
+		if err == nil {
+			return fmt.Errorf("Kafka Cluster still exists")
+		}
+	}
+
+	return nil
+}
+
+func testAccCheckExistsAndParseMDBKafkaCluster(n string, r **kafka.Cluster) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No ID is set")
+		}
+
+		config := test.AccProvider.(*provider.Provider).GetConfig()
+
+		found, err := config.SDK.MDB().Kafka().Cluster().Get(context.Background(), &kafka.GetClusterRequest{
+			ClusterId: rs.Primary.ID,
+		})
+		if err != nil {
+			return err
+		}
+
+		if found.Id != rs.Primary.ID {
+			return fmt.Errorf("Kafka Cluster not found")
+		}
+
+		*r = found
+
+		return nil
+	}
+}
+
+func testAccCheckMDBKafkaClusterIdNotChanged(n string, r **kafka.Cluster) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID != (*r).GetId() {
+			return fmt.Errorf("Kafka Cluster was recreated: expected id %s, actual %s", (*r).GetId(), rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckMDBKafkaClusterKafkaConfig3(r **kafka.Cluster, check func(*kafka.KafkaConfig3) error) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		c := (*r).GetConfig().GetKafka().GetKafkaConfig_3()
+		if c == nil {
+			return fmt.Errorf("Kafka Cluster %s has no kafka_config_3", (*r).GetId())
+		}
+		return check(c)
+	}
+}
+
+func testAccMDBKafkaClusterV2Basic(name, description, kafkaConfig string, diskSize int, weekly bool) string {
+	maintenanceWindow := `
+  maintenance_window = {
+    type = "ANYTIME"
+  }
+`
+	if weekly {
+		maintenanceWindow = `
+  maintenance_window = {
+    type = "WEEKLY"
+    day  = "MON"
+    hour = 5
+  }
+`
+	}
+
+	return fmt.Sprintf(kfVPCDependencies+`
+resource "yandex_mdb_kafka_cluster_v2" "foo" {
+  name        = "%s"
+  description = "%s"
+  network_id  = yandex_vpc_network.mdb-kafka-test-net.id
+  subnet_ids  = [yandex_vpc_subnet.mdb-kafka-test-subnet-a.id]
+
+  labels = {
+    test_key = "test_value"
+  }
+
+  version = "%s"
+  zones   = ["ru-central1-a"]
+
+  kafka = {
+    resources = {
+      resource_preset_id = "s2.micro"
+      disk_type_id       = "network-ssd"
+      disk_size          = %d
+    }
+    kafka_config = {
+      %s
+    }
+  }
+%s
+}
+`, name, description, kfVersion, diskSize, kafkaConfig, maintenanceWindow)
+}
+
+func testAccMDBKafkaClusterV2Kraft(name string) string {
+	return fmt.Sprintf(kfVPCDependencies+`
+resource "yandex_mdb_kafka_cluster_v2" "foo" {
+  name       = "%s"
+  network_id = yandex_vpc_network.mdb-kafka-test-net.id
+  subnet_ids = [
+    yandex_vpc_subnet.mdb-kafka-test-subnet-a.id,
+    yandex_vpc_subnet.mdb-kafka-test-subnet-b.id,
+    yandex_vpc_subnet.mdb-kafka-test-subnet-d.id,
+  ]
+
+  version = "%s"
+  zones   = ["ru-central1-a", "ru-central1-b", "ru-central1-d"]
+
+  kafka = {
+    resources = {
+      resource_preset_id = "s2.micro"
+      disk_type_id       = "network-ssd"
+      disk_size          = 16
+    }
+  }
+
+  kraft = {
+    resources = {
+      resource_preset_id = "s2.micro"
+      disk_type_id       = "network-ssd"
+      disk_size          = 10
+    }
+  }
+}
+`, name, kfVersion)
+}
+
+func testAccMDBKafkaClusterV1(name string) string {
+	return fmt.Sprintf(kfVPCDependencies+`
+resource "yandex_mdb_kafka_cluster" "foo" {
+  name       = "%s"
+  network_id = yandex_vpc_network.mdb-kafka-test-net.id
+
+  config {
+    version = "%s"
+    zones   = ["ru-central1-a"]
+
+    kafka {
+      resources {
+        resource_preset_id = "s2.micro"
+        disk_type_id       = "network-ssd"
+        disk_size          = 16
+      }
+      kafka_config {
+        compression_type = "COMPRESSION_TYPE_ZSTD"
+        num_partitions   = 3
+      }
+    }
+  }
+}
+`, name, kfVersion)
+}
+
+// testAccMDBKafkaClusterV2Migrated describes the same cluster as testAccMDBKafkaClusterV1
+func testAccMDBKafkaClusterV2Migrated(name string) string {
+	return fmt.Sprintf(kfVPCDependencies+`
+moved {
+  from = yandex_mdb_kafka_cluster.foo
+  to   = yandex_mdb_kafka_cluster_v2.foo
+}
+
+resource "yandex_mdb_kafka_cluster_v2" "foo" {
+  name       = "%s"
+  network_id = yandex_vpc_network.mdb-kafka-test-net.id
+
+  version = "%s"
+  zones   = ["ru-central1-a"]
+
+  kafka = {
+    resources = {
+      resource_preset_id = "s2.micro"
+      disk_type_id       = "network-ssd"
+      disk_size          = 16
+    }
+    kafka_config = {
+      compression_type = "COMPRESSION_TYPE_ZSTD"
+      num_partitions   = 3
+    }
+  }
+}
+`, name, kfVersion)
+}
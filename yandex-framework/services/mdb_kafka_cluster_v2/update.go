@@ -0,0 +1,236 @@
+package mdb_kafka_cluster_v2
+
+import (
+	"context"
+	"fmt"
+	"maps"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/yandex-cloud/go-genproto/yandex/cloud/mdb/kafka/v1"
+	"github.com/yandex-cloud/terraform-provider-yandex/pkg/datasize"
+	"github.com/yandex-cloud/terraform-provider-yandex/pkg/mdbcommon"
+	"google.golang.org/genproto/protobuf/field_mask"
+)
+
+func prepareVersionUpdateRequest(state, plan *Cluster) (*kafka.UpdateClusterRequest, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	sv := state.Version
+	pv := plan.Version
+
+	if pv.Equal(sv) {
+		return nil, diags
+	}
+
+	return &kafka.UpdateClusterRequest{
+		ClusterId: state.Id.ValueString(),
+		ConfigSpec: &kafka.ConfigSpec{
+			Version: pv.ValueString(),
+		},
+		UpdateMask: &field_mask.FieldMask{Paths: []string{"config_spec.version"}},
+	}, diags
+}
+
+// resourcesUpdatePaths returns update mask paths for changed attributes of a resources object
+func resourcesUpdatePaths(ctx context.Context, prefix string, state, plan types.Object, diags *diag.Diagnostics) []string {
+	var sr, pr Resources
+	diags.Append(state.As(ctx, &sr, datasize.UnhandledOpts)...)
+	diags.Append(plan.As(ctx, &pr, datasize.UnhandledOpts)...)
+
+	var paths []string
+	if !pr.ResourcePresetID.Equal(sr.ResourcePresetID) {
+		paths = append(paths, prefix+".resources.resource_preset_id")
+	}
+	if !pr.DiskSize.Equal(sr.DiskSize) {
+		paths = append(paths, prefix+".resources.disk_size")
+	}
+	if !pr.DiskTypeID.Equal(sr.DiskTypeID) {
+		paths = append(paths, prefix+".resources.disk_type_id")
+	}
+	return paths
+}
+
+func subclusterUpdatePaths(ctx context.Context, prefix string, state, plan types.Object, diags *diag.Diagnostics) []string {
+	var ss, ps Subcluster
+	diags.Append(state.As(ctx, &ss, datasize.UnhandledOpts)...)
+	diags.Append(plan.As(ctx, &ps, datasize.UnhandledOpts)...)
+
+	return resourcesUpdatePaths(ctx, prefix, ss.Resources, ps.Resources, diags)
+}
+
+func prepareUpdateRequest(ctx context.Context, state, plan *Cluster) (*kafka.UpdateClusterRequest, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	request := &kafka.UpdateClusterRequest{
+		ClusterId:  state.Id.ValueString(),
+		UpdateMask: &field_mask.FieldMask{},
+	}
+
+	if !plan.Name.Equal(state.Name) {
+		request.SetName(plan.Name.ValueString())
+		request.UpdateMask.Paths = append(request.UpdateMask.Paths, "name")
+	}
+
+	if !plan.Description.Equal(state.Description) {
+		request.SetDescription(plan.Description.ValueString())
+		request.UpdateMask.Paths = append(request.UpdateMask.Paths, "description")
+	}
+
+	if !plan.Labels.Equal(state.Labels) {
+		request.SetLabels(mdbcommon.ExpandLabels(ctx, plan.Labels, &diags))
+		request.UpdateMask.Paths = append(request.UpdateMask.Paths, "labels")
+	}
+
+	if !plan.NetworkId.Equal(state.NetworkId) {
+		request.SetNetworkId(plan.NetworkId.ValueString())
+		request.UpdateMask.Paths = append(request.UpdateMask.Paths, "network_id")
+	}
+
+	if !plan.SubnetIds.Equal(state.SubnetIds) {
+		request.SetSubnetIds(expandStringSet(ctx, plan.SubnetIds, &diags))
+		request.UpdateMask.Paths = append(request.UpdateMask.Paths, "subnet_ids")
+	}
+
+	config := &kafka.ConfigSpec{}
+	updConf := false
+
+	if !plan.Zones.Equal(state.Zones) {
+		updConf = true
+		config.SetZoneId(expandZones(ctx, plan.Zones, &diags))
+		request.UpdateMask.Paths = append(request.UpdateMask.Paths, "config_spec.zone_id")
+	}
+
+	if !plan.BrokersCount.Equal(state.BrokersCount) {
+		updConf = true
+		config.SetBrokersCount(mdbcommon.ExpandInt64Wrapper(ctx, plan.BrokersCount, &diags))
+		request.UpdateMask.Paths = append(request.UpdateMask.Paths, "config_spec.brokers_count")
+	}
+
+	if !plan.AssignPublicIp.Equal(state.AssignPublicIp) {
+		updConf = true
+		config.SetAssignPublicIp(plan.AssignPublicIp.ValueBool())
+		request.UpdateMask.Paths = append(request.UpdateMask.Paths, "config_spec.assign_public_ip")
+	}
+
+	if !plan.SchemaRegistry.Equal(state.SchemaRegistry) {
+		updConf = true
+		config.SetSchemaRegistry(plan.SchemaRegistry.ValueBool())
+		request.UpdateMask.Paths = append(request.UpdateMask.Paths, "config_spec.schema_registry")
+	}
+
+	if !plan.Access.Equal(state.Access) {
+		updConf = true
+		config.SetAccess(expandAccess(ctx, plan.Access, &diags))
+		request.UpdateMask.Paths = append(request.UpdateMask.Paths, "config_spec.access.data_transfer")
+	}
+
+	if !plan.RestAPI.Equal(state.RestAPI) {
+		updConf = true
+		config.SetRestApiConfig(expandRestAPIConfig(ctx, plan.RestAPI, &diags))
+		request.UpdateMask.Paths = append(request.UpdateMask.Paths, "config_spec.rest_api_config.enabled")
+	}
+
+	if !plan.KafkaUI.Equal(state.KafkaUI) {
+		updConf = true
+		config.SetKafkaUiConfig(expandKafkaUIConfig(ctx, plan.KafkaUI, &diags))
+		request.UpdateMask.Paths = append(request.UpdateMask.Paths, "config_spec.kafka_ui_config.enabled")
+	}
+
+	if !plan.DiskSizeAutoscaling.Equal(state.DiskSizeAutoscaling) {
+		updConf = true
+		config.SetDiskSizeAutoscaling(expandDiskSizeAutoscaling(ctx, plan.DiskSizeAutoscaling, &diags))
+
+		var psda, sdsa DiskSizeAutoscaling
+		diags.Append(state.DiskSizeAutoscaling.As(ctx, &sdsa, datasize.UnhandledOpts)...)
+		diags.Append(plan.DiskSizeAutoscaling.As(ctx, &psda, datasize.UnhandledOpts)...)
+
+		if !psda.DiskSizeLimit.Equal(sdsa.DiskSizeLimit) {
+			request.UpdateMask.Paths = append(
+				request.UpdateMask.Paths,
+				"config_spec.disk_size_autoscaling.disk_size_limit",
+			)
+		}
+		if !psda.PlannedUsageThreshold.Equal(sdsa.PlannedUsageThreshold) {
+			request.UpdateMask.Paths = append(
+				request.UpdateMask.Paths,
+				"config_spec.disk_size_autoscaling.planned_usage_threshold",
+			)
+		}
+		if !psda.EmergencyUsageThreshold.Equal(sdsa.EmergencyUsageThreshold) {
+			request.UpdateMask.Paths = append(
+				request.UpdateMask.Paths,
+				"config_spec.disk_size_autoscaling.emergency_usage_threshold",
+			)
+		}
+	}
+
+	if !plan.Kafka.Equal(state.Kafka) {
+		var pk, sk Kafka
+		diags.Append(state.Kafka.As(ctx, &sk, datasize.UnhandledOpts)...)
+		diags.Append(plan.Kafka.As(ctx, &pk, datasize.UnhandledOpts)...)
+
+		kafkaPaths := resourcesUpdatePaths(ctx, "config_spec.kafka", sk.Resources, pk.Resources, &diags)
+
+		if !pk.KafkaConfig.Equal(sk.KafkaConfig) {
+			attrsState := mdbcommon.GetAttrNamesSetFromMap(sk.KafkaConfig.MapValue, &diags)
+			attrsPlan := mdbcommon.GetAttrNamesSetFromMap(pk.KafkaConfig.MapValue, &diags)
+
+			maps.Copy(attrsPlan, attrsState)
+			for attr := range attrsPlan {
+				kafkaPaths = append(kafkaPaths, fmt.Sprintf(
+					"config_spec.kafka.kafka_config_%s.%s",
+					getKafkaConfigVersionSuffix(plan.Version.ValueString()), attr,
+				))
+			}
+		}
+
+		if len(kafkaPaths) > 0 {
+			updConf = true
+			config.SetKafka(expandKafka(ctx, plan.Version.ValueString(), plan.Kafka, &diags))
+			request.UpdateMask.Paths = append(request.UpdateMask.Paths, kafkaPaths...)
+		}
+	}
+
+	if !plan.Zookeeper.Equal(state.Zookeeper) {
+		if paths := subclusterUpdatePaths(ctx, "config_spec.zookeeper", state.Zookeeper, plan.Zookeeper, &diags); len(paths) > 0 {
+			updConf = true
+			config.SetZookeeper(expandZookeeper(ctx, plan.Zookeeper, &diags))
+			request.UpdateMask.Paths = append(request.UpdateMask.Paths, paths...)
+		}
+	}
+
+	if !plan.Kraft.Equal(state.Kraft) {
+		if paths := subclusterUpdatePaths(ctx, "config_spec.kraft", state.Kraft, plan.Kraft, &diags); len(paths) > 0 {
+			updConf = true
+			config.SetKraft(expandKraft(ctx, plan.Kraft, &diags))
+			request.UpdateMask.Paths = append(request.UpdateMask.Paths, paths...)
+		}
+	}
+
+	if updConf {
+		request.SetConfigSpec(config)
+	}
+
+	if !plan.DeletionProtection.Equal(state.DeletionProtection) {
+		request.SetDeletionProtection(plan.DeletionProtection.ValueBool())
+		request.UpdateMask.Paths = append(request.UpdateMask.Paths, "deletion_protection")
+	}
+
+	if !plan.SecurityGroupIds.Equal(state.SecurityGroupIds) {
+		request.SetSecurityGroupIds(mdbcommon.ExpandSecurityGroupIds(ctx, plan.SecurityGroupIds, &diags))
+		request.UpdateMask.Paths = append(request.UpdateMask.Paths, "security_group_ids")
+	}
+
+	if !plan.MaintenanceWindow.Equal(state.MaintenanceWindow) {
+		request.SetMaintenanceWindow(mdbcommon.ExpandClusterMaintenanceWindow[
+			kafka.MaintenanceWindow,
+			kafka.WeeklyMaintenanceWindow,
+			kafka.AnytimeMaintenanceWindow,
+			kafka.WeeklyMaintenanceWindow_WeekDay,
+		](ctx, plan.MaintenanceWindow, &diags))
+		request.UpdateMask.Paths = append(request.UpdateMask.Paths, "maintenance_window")
+	}
+
+	return request, diags
+}
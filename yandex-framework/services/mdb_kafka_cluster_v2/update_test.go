@@ -0,0 +1,208 @@
+package mdb_kafka_cluster_v2
+
+import (
+	"context"
+	"reflect"
+	"sort"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/yandex-cloud/go-genproto/yandex/cloud/mdb/kafka/v1"
+	"github.com/yandex-cloud/terraform-provider-yandex/pkg/datasize"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+)
+
+var baseCluster = Cluster{
+	Id:          types.StringValue("test-id"),
+	FolderId:    types.StringValue("test-folder"),
+	NetworkId:   types.StringValue("test-network"),
+	Name:        types.StringValue("test-cluster"),
+	Description: types.StringValue("test-description"),
+	Environment: types.StringValue("PRODUCTION"),
+	Labels: types.MapValueMust(types.StringType, map[string]attr.Value{
+		"key": types.StringValue("value"),
+	}),
+	SubnetIds:          types.SetNull(types.StringType),
+	SecurityGroupIds:   types.SetNull(types.StringType),
+	HostGroupIds:       types.SetNull(types.StringType),
+	DeletionProtection: types.BoolValue(true),
+	MaintenanceWindow: types.ObjectValueMust(MaintenanceWindowAttrTypes, map[string]attr.Value{
+		"type": types.StringValue("ANYTIME"),
+		"day":  types.StringNull(),
+		"hour": types.Int64Null(),
+	}),
+	Version: types.StringValue("3.6"),
+	Zones: types.ListValueMust(types.StringType, []attr.Value{
+		types.StringValue("ru-central1-a"),
+	}),
+	BrokersCount:   types.Int64Value(1),
+	AssignPublicIp: types.BoolValue(false),
+	SchemaRegistry: types.BoolValue(false),
+	Access: types.ObjectValueMust(AccessAttrTypes, map[string]attr.Value{
+		"data_transfer": types.BoolValue(false),
+	}),
+	RestAPI: types.ObjectValueMust(EnabledAttrTypes, map[string]attr.Value{
+		"enabled": types.BoolValue(false),
+	}),
+	KafkaUI: types.ObjectValueMust(EnabledAttrTypes, map[string]attr.Value{
+		"enabled": types.BoolValue(false),
+	}),
+	DiskSizeAutoscaling: types.ObjectNull(DiskSizeAutoscalingAttrTypes),
+	Kafka: buildTestKafkaObj(
+		buildTestResourcesObj("s2.micro", 16, "network-ssd"),
+		NewKafkaSettingsMapValueMust(map[string]attr.Value{
+			"num_partitions":      types.Int64Value(3),
+			"log_retention_hours": types.Int64Value(24),
+		}),
+	),
+	Zookeeper: buildTestSubclusterObj(buildTestResourcesObj("s2.micro", 10, "network-ssd")),
+	Kraft:     types.ObjectNull(SubclusterAttrTypes),
+}
+
+func TestYandexProvider_MDBKafkaClusterPrepareUpdateRequestBasic(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+
+	cluster := baseCluster
+
+	cluster.Name = types.StringValue("test-cluster-new")
+	cluster.DeletionProtection = types.BoolValue(false)
+	cluster.BrokersCount = types.Int64Value(2)
+	cluster.KafkaUI = types.ObjectValueMust(EnabledAttrTypes, map[string]attr.Value{
+		"enabled": types.BoolValue(true),
+	})
+	cluster.Kafka = buildTestKafkaObj(
+		buildTestResourcesObj("s2.small", 16, "network-ssd"),
+		NewKafkaSettingsMapValueMust(map[string]attr.Value{
+			"num_partitions": types.Int64Value(5),
+		}),
+	)
+	cluster.Zookeeper = buildTestSubclusterObj(buildTestResourcesObj("s2.micro", 20, "network-ssd"))
+
+	req, diags := prepareUpdateRequest(ctx, &baseCluster, &cluster)
+	if diags.HasError() {
+		t.Fatalf(
+			"Unexpected expand diagnostics status: expected without error, actual with errors: %v",
+			diags.Errors(),
+		)
+	}
+
+	expectedUpdateReq := &kafka.UpdateClusterRequest{
+		ClusterId: "test-id",
+		Name:      "test-cluster-new",
+		ConfigSpec: &kafka.ConfigSpec{
+			BrokersCount:  wrapperspb.Int64(2),
+			KafkaUiConfig: &kafka.ConfigSpec_KafkaUIConfig{Enabled: true},
+			Kafka: &kafka.ConfigSpec_Kafka{
+				Resources: &kafka.Resources{
+					ResourcePresetId: "s2.small",
+					DiskSize:         datasize.ToBytes(16),
+					DiskTypeId:       "network-ssd",
+				},
+				KafkaConfig: &kafka.ConfigSpec_Kafka_KafkaConfig_3{
+					KafkaConfig_3: &kafka.KafkaConfig3{
+						NumPartitions: wrapperspb.Int64(5),
+					},
+				},
+			},
+			Zookeeper: &kafka.ConfigSpec_Zookeeper{
+				Resources: &kafka.Resources{
+					ResourcePresetId: "s2.micro",
+					DiskSize:         datasize.ToBytes(20),
+					DiskTypeId:       "network-ssd",
+				},
+			},
+		},
+		DeletionProtection: false,
+		UpdateMask: &fieldmaskpb.FieldMask{
+			Paths: []string{
+				"name",
+				"config_spec.brokers_count",
+				"config_spec.kafka_ui_config.enabled",
+				"config_spec.kafka.resources.resource_preset_id",
+				"config_spec.kafka.kafka_config_3.num_partitions",
+				"config_spec.kafka.kafka_config_3.log_retention_hours",
+				"config_spec.zookeeper.resources.disk_size",
+				"deletion_protection",
+			},
+		},
+	}
+
+	sort.Strings(req.UpdateMask.Paths)
+	sort.Strings(expectedUpdateReq.UpdateMask.Paths)
+
+	if !reflect.DeepEqual(req.UpdateMask.Paths, expectedUpdateReq.UpdateMask.Paths) {
+		t.Fatalf("Unexpected update mask paths: expected %s, actual %s", expectedUpdateReq.UpdateMask, req.UpdateMask)
+	}
+
+	if !reflect.DeepEqual(req, expectedUpdateReq) {
+		t.Fatalf("Unexpected update request:\nexpected %s\nactual %s", expectedUpdateReq, req)
+	}
+}
+
+func TestYandexProvider_MDBKafkaClusterPrepareUpdateRequestNoChanges(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+
+	cluster := baseCluster
+
+	req, diags := prepareUpdateRequest(ctx, &baseCluster, &cluster)
+	if diags.HasError() {
+		t.Fatalf("Unexpected expand diagnostics: %v", diags.Errors())
+	}
+
+	if len(req.UpdateMask.Paths) != 0 {
+		t.Fatalf("Unexpected update mask paths: expected empty, actual %s", req.UpdateMask)
+	}
+}
+
+func TestYandexProvider_MDBKafkaClusterPrepareUpdateVersionRequest(t *testing.T) {
+	t.Parallel()
+
+	cluster := baseCluster
+
+	cluster.Version = types.StringValue("3.9")
+
+	req, diags := prepareVersionUpdateRequest(&baseCluster, &cluster)
+	if diags.HasError() {
+		t.Fatalf(
+			"Unexpected expand diagnostics status: expected without error, actual with errors: %v",
+			diags.Errors(),
+		)
+	}
+
+	expectedUpdateReq := &kafka.UpdateClusterRequest{
+		ClusterId: "test-id",
+		ConfigSpec: &kafka.ConfigSpec{
+			Version: "3.9",
+		},
+		UpdateMask: &fieldmaskpb.FieldMask{
+			Paths: []string{
+				"config_spec.version",
+			},
+		},
+	}
+
+	if !reflect.DeepEqual(req, expectedUpdateReq) {
+		t.Fatalf("Unexpected update request:\nexpected %s\nactual %s", expectedUpdateReq, req)
+	}
+}
+
+func TestYandexProvider_MDBKafkaClusterKafkaConfigVersionSuffix(t *testing.T) {
+	t.Parallel()
+
+	cases := map[string]string{
+		"2.8": "2_8",
+		"3.5": "3",
+		"3.9": "3",
+		"4.0": "3",
+	}
+
+	for version, expected := range cases {
+		if actual := getKafkaConfigVersionSuffix(version); actual != expected {
+			t.Errorf("Unexpected kafka config suffix for version %s: expected %s, actual %s", version, expected, actual)
+		}
+	}
+}
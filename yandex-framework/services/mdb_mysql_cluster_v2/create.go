@@ -33,7 +33,7 @@ func prepareCreateRequest(
 		Environment:        mdbcommon.ExpandEnvironment[mysql.Cluster_Environment](ctx, plan.Environment, &diags),
 		Labels:             mdbcommon.ExpandLabels(ctx, plan.Labels, &diags),
 		ConfigSpec:         expandConfig(ctx, cfg, &diags),
-		DeletionProtection: plan.DeletionProtection.ValueBool(),
+		DeletionProtection: mdbcommon.ExpandDeletionProtection(ctx, plan.DeletionProtection, &diags),
 		HostSpecs:          hostSpecsSlice,
 		MaintenanceWindow: mdbcommon.ExpandClusterMaintenanceWindow[
 			mysql.MaintenanceWindow,
@@ -96,7 +96,7 @@ func prepareRestoreRequest(
 		Environment:        mdbcommon.ExpandEnvironment[mysql.Cluster_Environment](ctx, plan.Environment, &diags),
 		Labels:             mdbcommon.ExpandLabels(ctx, plan.Labels, &diags),
 		ConfigSpec:         expandConfig(ctx, cfg, &diags),
-		DeletionProtection: plan.DeletionProtection.ValueBool(),
+		DeletionProtection: mdbcommon.ExpandDeletionProtection(ctx, plan.DeletionProtection, &diags),
 		HostSpecs:          hostSpecsSlice,
 		MaintenanceWindow: mdbcommon.ExpandClusterMaintenanceWindow[
 			mysql.MaintenanceWindow,
@@ -113,7 +113,7 @@ var (
 		Access:             types.ObjectNull(AccessAttrTypes),
 		DeletionProtection: types.BoolValue(true),
 		SecurityGroupIds: types.SetValueMust(types.StringType, []attr.Value{
-			types.StringValue("test-sg"),
+			types.StringValue("enp1sg00000000000001"),
 		}),
 		MySQLConfig: NewMsSettingsMapValueMust(map[string]attr.Value{
 			"max_connections": types.Int64Value(100),
@@ -183,7 +183,7 @@ func TestYandexProvider_MDBMySQLClusterPrepareCreateRequest(t *testing.T) {
 					),
 					"deletion_protection": types.BoolValue(true),
 					"security_group_ids": types.SetValueMust(types.StringType, []attr.Value{
-						types.StringValue("test-sg"),
+						types.StringValue("enp1sg00000000000001"),
 					}),
 					"restore": types.ObjectValueMust(expectedRestoreAttrTypes, map[string]attr.Value{
 						"backup_id": types.StringNull(),
@@ -225,7 +225,7 @@ func TestYandexProvider_MDBMySQLClusterPrepareCreateRequest(t *testing.T) {
 						},
 					},
 				},
-				SecurityGroupIds:   []string{"test-sg"},
+				SecurityGroupIds:   []string{"enp1sg00000000000001"},
 				DeletionProtection: true,
 				FolderId:           "test-folder",
 				MaintenanceWindow: &mysql.MaintenanceWindow{
@@ -454,7 +454,7 @@ func TestYandexProvider_MDBMySQLClusterPrepareRestoreRequest(t *testing.T) {
 					),
 					"deletion_protection": types.BoolValue(true),
 					"security_group_ids": types.SetValueMust(types.StringType, []attr.Value{
-						types.StringValue("test-sg"),
+						types.StringValue("enp1sg00000000000001"),
 					}),
 					"restore": types.ObjectValueMust(expectedRestoreAttrTypes, map[string]attr.Value{
 						"backup_id": types.StringValue("backup_id"),
@@ -498,7 +498,7 @@ func TestYandexProvider_MDBMySQLClusterPrepareRestoreRequest(t *testing.T) {
 						},
 					},
 				},
-				SecurityGroupIds:   []string{"test-sg"},
+				SecurityGroupIds:   []string{"enp1sg00000000000001"},
 				DeletionProtection: true,
 				FolderId:           "test-folder",
 				MaintenanceWindow: &mysql.MaintenanceWindow{
@@ -7,30 +7,12 @@ import (
 
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/types"
-	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
 	"github.com/yandex-cloud/go-genproto/yandex/cloud/mdb/mysql/v1"
 	protobuf_adapter "github.com/yandex-cloud/terraform-provider-yandex/pkg/adapters/protobuf"
 	"github.com/yandex-cloud/terraform-provider-yandex/pkg/datasize"
 	"github.com/yandex-cloud/terraform-provider-yandex/pkg/mdbcommon"
 )
 
-// Set access to default if null
-func expandAccess(ctx context.Context, cfgAccess types.Object, diags *diag.Diagnostics) *mysql.Access {
-	var access Access
-	diags.Append(cfgAccess.As(ctx, &access, basetypes.ObjectAsOptions{
-		UnhandledNullAsEmpty:    true,
-		UnhandledUnknownAsEmpty: true,
-	})...)
-	if diags.HasError() {
-		return nil
-	}
-	return &mysql.Access{
-		WebSql:       access.WebSql.ValueBool(),
-		DataLens:     access.DataLens.ValueBool(),
-		DataTransfer: access.DataTransfer.ValueBool(),
-	}
-}
-
 const (
 	anytimeType = "ANYTIME" //nolint:unused
 	weeklyType  = "WEEKLY"  //nolint:unused
@@ -104,10 +86,10 @@ func expandConfig(ctx context.Context, configSpec Config, diags *diag.Diagnostic
 	return &mysql.ConfigSpec{
 		Version:                configSpec.Version.ValueString(),
 		Resources:              mdbcommon.ExpandResources[mysql.Resources](ctx, configSpec.Resources, diags),
-		Access:                 expandAccess(ctx, configSpec.Access, diags),
+		Access:                 mdbcommon.ExpandAccess[mysql.Access](ctx, configSpec.Access, diags),
 		PerformanceDiagnostics: expandPerformanceDiagnostics(ctx, configSpec.PerformanceDiagnostics, diags),
 		DiskSizeAutoscaling:    expandDiskAutoScaling(ctx, configSpec.DiskSizeAutoscaling, diags),
-		BackupRetainPeriodDays: mdbcommon.ExpandInt64Wrapper(ctx, configSpec.BackupRetainPeriodDays, diags),
+		BackupRetainPeriodDays: mdbcommon.ExpandRetainPeriod(ctx, configSpec.BackupRetainPeriodDays, diags),
 		BackupWindowStart:      mdbcommon.ExpandBackupWindow(ctx, configSpec.BackupWindowStart, diags),
 		MysqlConfig:            expandMySQLConfig(ctx, configSpec.Version.ValueString(), configSpec.MySQLConfig, diags),
 	}
@@ -13,23 +13,6 @@ import (
 	"github.com/yandex-cloud/terraform-provider-yandex/pkg/mdbcommon"
 )
 
-func flattenAccess(ctx context.Context, pgAccess *mysql.Access, diags *diag.Diagnostics) types.Object {
-	if pgAccess == nil {
-		return types.ObjectNull(AccessAttrTypes)
-	}
-
-	obj, d := types.ObjectValueFrom(
-		ctx, AccessAttrTypes, Access{
-			DataLens:     types.BoolValue(pgAccess.DataLens),
-			DataTransfer: types.BoolValue(pgAccess.DataTransfer),
-			WebSql:       types.BoolValue(pgAccess.WebSql),
-		},
-	)
-	diags.Append(d...)
-
-	return obj
-}
-
 func flattenPerformanceDiagnostics(ctx context.Context, pd *mysql.PerformanceDiagnostics, diags *diag.Diagnostics) types.Object {
 	if pd == nil {
 		return types.ObjectNull(PerformanceDiagnosticsAttrTypes)
@@ -81,10 +64,10 @@ func flattenConfig(
 	return Config{
 		Version:                types.StringValue(c.Version),
 		Resources:              mdbcommon.FlattenResources(ctx, c.Resources, diags),
-		Access:                 flattenAccess(ctx, c.Access, diags),
+		Access:                 mdbcommon.FlattenAccess(ctx, c.Access, diags),
 		PerformanceDiagnostics: flattenPerformanceDiagnostics(ctx, c.PerformanceDiagnostics, diags),
 		DiskSizeAutoscaling:    flattenDiskSizeAutoscaling(ctx, c.DiskSizeAutoscaling, diags),
-		BackupRetainPeriodDays: mdbcommon.FlattenInt64Wrapper(ctx, c.BackupRetainPeriodDays, diags),
+		BackupRetainPeriodDays: mdbcommon.FlattenRetainPeriod(ctx, c.BackupRetainPeriodDays, diags),
 		BackupWindowStart:      mdbcommon.FlattenBackupWindowStart(ctx, c.BackupWindowStart, diags),
 		MySQLConfig:            stateMSCfg,
 	}
@@ -4,6 +4,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/yandex-cloud/go-genproto/yandex/cloud/mdb/mysql/v1"
 	"github.com/yandex-cloud/terraform-provider-yandex/pkg/mdbcommon"
 )
 
@@ -88,13 +89,10 @@ type Access struct {
 	DataLens     types.Bool `tfsdk:"data_lens"`
 	WebSql       types.Bool `tfsdk:"web_sql"`
 	DataTransfer types.Bool `tfsdk:"data_transfer"`
+	YandexQuery  types.Bool `tfsdk:"yandex_query"`
 }
 
-var AccessAttrTypes = map[string]attr.Type{
-	"data_lens":     types.BoolType,
-	"web_sql":       types.BoolType,
-	"data_transfer": types.BoolType,
-}
+var AccessAttrTypes = mdbcommon.AccessAttrTypesOf[mysql.Access]()
 
 type PerformanceDiagnostics struct {
 	Enabled                    types.Bool  `tfsdk:"enabled"`
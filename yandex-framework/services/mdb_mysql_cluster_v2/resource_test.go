@@ -220,6 +220,7 @@ func TestAccMDBMySQLCluster_basic(t *testing.T) {
 				"data_lens":     knownvalue.Bool(false),
 				"data_transfer": knownvalue.Bool(false),
 				"web_sql":       knownvalue.Bool(false),
+				"yandex_query":  knownvalue.Bool(false),
 			},
 		)),
 		statecheck.ExpectKnownValue(clusterResource, tfjsonpath.New("backup_retain_period_days"), knownvalue.Int64Exact(7)),
@@ -322,6 +323,7 @@ func TestAccMDBMySQLCluster_basic(t *testing.T) {
 							"data_lens":     knownvalue.Bool(false),
 							"data_transfer": knownvalue.Bool(false),
 							"web_sql":       knownvalue.Bool(false),
+							"yandex_query":  knownvalue.Bool(false),
 						},
 					)),
 					statecheck.ExpectKnownValue(clusterResource, tfjsonpath.New("performance_diagnostics"), knownvalue.ObjectExact(map[string]knownvalue.Check{
@@ -425,6 +427,7 @@ func TestAccMDBMySQLCluster_full(t *testing.T) {
 	access := `
 		data_transfer = true
 		web_sql = true
+		yandex_query = true
 		data_lens = false
 	`
 
@@ -432,6 +435,7 @@ func TestAccMDBMySQLCluster_full(t *testing.T) {
 		data_lens = true
 		data_transfer = false
 		web_sql = false
+		yandex_query = false
 	`
 
 	performanceDiagnostics := `
@@ -525,6 +529,7 @@ func TestAccMDBMySQLCluster_full(t *testing.T) {
 							"data_lens":     knownvalue.Bool(false),
 							"data_transfer": knownvalue.Bool(true),
 							"web_sql":       knownvalue.Bool(true),
+							"yandex_query":  knownvalue.Bool(true),
 						},
 					)),
 					statecheck.ExpectKnownValue(clusterResource, tfjsonpath.New("performance_diagnostics"), knownvalue.ObjectExact(
@@ -581,6 +586,7 @@ func TestAccMDBMySQLCluster_full(t *testing.T) {
 						DataLens:     false,
 						DataTransfer: true,
 						WebSql:       true,
+						YandexQuery:  true,
 					}),
 					testAccCheckClusterPerformanceDiagnosticsExact(
 						&cluster,
@@ -662,6 +668,7 @@ func TestAccMDBMySQLCluster_full(t *testing.T) {
 							"data_lens":     knownvalue.Bool(true),
 							"data_transfer": knownvalue.Bool(false),
 							"web_sql":       knownvalue.Bool(false),
+							"yandex_query":  knownvalue.Bool(false),
 						},
 					)),
 					statecheck.ExpectKnownValue(clusterResource, tfjsonpath.New("performance_diagnostics"), knownvalue.ObjectExact(
@@ -843,6 +850,7 @@ func TestAccMDBMySQLCluster_mixed(t *testing.T) {
 						"data_lens":     knownvalue.Bool(false),
 						"data_transfer": knownvalue.Bool(false),
 						"web_sql":       knownvalue.Bool(false),
+						"yandex_query":  knownvalue.Bool(false),
 					},
 				)),
 				statecheck.ExpectKnownValue(clusterResource, tfjsonpath.New("performance_diagnostics"), knownvalue.ObjectExact(
@@ -931,6 +939,7 @@ func TestAccMDBMySQLCluster_mixed(t *testing.T) {
 						"data_lens":     knownvalue.Bool(false),
 						"data_transfer": knownvalue.Bool(false),
 						"web_sql":       knownvalue.Bool(false),
+						"yandex_query":  knownvalue.Bool(false),
 					},
 				)),
 				statecheck.ExpectKnownValue(clusterResource, tfjsonpath.New("performance_diagnostics"), knownvalue.ObjectExact(
@@ -1014,6 +1023,7 @@ func TestAccMDBMySQLCluster_mixed(t *testing.T) {
 						"data_lens":     knownvalue.Bool(false),
 						"data_transfer": knownvalue.Bool(false),
 						"web_sql":       knownvalue.Bool(false),
+						"yandex_query":  knownvalue.Bool(false),
 					},
 				)),
 				statecheck.ExpectKnownValue(clusterResource, tfjsonpath.New("performance_diagnostics"), knownvalue.ObjectExact(
@@ -72,7 +72,7 @@ func prepareUpdateRequest(ctx context.Context, state, plan *Cluster) (*mysql.Upd
 
 	if !plan.Access.Equal(state.Access) {
 		updConf = true
-		config.SetAccess(expandAccess(ctx, plan.Access, &diags))
+		config.SetAccess(mdbcommon.ExpandAccess[mysql.Access](ctx, plan.Access, &diags))
 
 		var pa, sa Access
 		diags.Append(state.Access.As(ctx, &sa, datasize.UnhandledOpts)...)
@@ -96,6 +96,12 @@ func prepareUpdateRequest(ctx context.Context, state, plan *Cluster) (*mysql.Upd
 				"config_spec.access.data_lens",
 			)
 		}
+		if !pa.YandexQuery.Equal(sa.YandexQuery) {
+			request.UpdateMask.Paths = append(
+				request.UpdateMask.Paths,
+				"config_spec.access.yandex_query",
+			)
+		}
 	}
 
 	if !plan.PerformanceDiagnostics.Equal(state.PerformanceDiagnostics) {
@@ -156,7 +162,7 @@ func prepareUpdateRequest(ctx context.Context, state, plan *Cluster) (*mysql.Upd
 
 	if !plan.BackupRetainPeriodDays.Equal(state.BackupRetainPeriodDays) {
 		updConf = true
-		config.SetBackupRetainPeriodDays(mdbcommon.ExpandInt64Wrapper(ctx, plan.BackupRetainPeriodDays, &diags))
+		config.SetBackupRetainPeriodDays(mdbcommon.ExpandRetainPeriod(ctx, plan.BackupRetainPeriodDays, &diags))
 		request.UpdateMask.Paths = append(request.UpdateMask.Paths, "config_spec.backup_retain_period_days")
 	}
 
@@ -30,7 +30,7 @@ func TestYandexProvider_MDBMySQLClusterPrepateUpdateRequestBasic(t *testing.T) {
 	cluster.Name = types.StringValue("test-cluster-new")
 	cluster.DeletionProtection = types.BoolValue(false)
 	cluster.SecurityGroupIds = types.SetValueMust(types.StringType, []attr.Value{
-		types.StringValue("test-sg-new"),
+		types.StringValue("enp1sg00000000000002"),
 	})
 	cluster.MaintenanceWindow = types.ObjectNull(expectedMWAttrs)
 	cluster.MySQLConfig = NewMsSettingsMapValueMust(
@@ -62,7 +62,7 @@ func TestYandexProvider_MDBMySQLClusterPrepateUpdateRequestBasic(t *testing.T) {
 			},
 		},
 		MaintenanceWindow:  nil,
-		SecurityGroupIds:   []string{"test-sg-new"},
+		SecurityGroupIds:   []string{"enp1sg00000000000002"},
 		DeletionProtection: false,
 		UpdateMask: &fieldmaskpb.FieldMask{
 			Paths: []string{
@@ -71,7 +71,7 @@ func ClusterToState(ctx context.Context, cluster *opensearch.Cluster, state *Ope
 		state.Labels = labels
 	}
 
-	state.Environment = types.StringValue(cluster.GetEnvironment().String())
+	state.Environment = mdbcommon.FlattenEnvironment(ctx, state.Environment, cluster.GetEnvironment().String(), &diags)
 
 	state.Config, diags = configToState(ctx, cluster.Config, state)
 	if diags.HasError() {
@@ -31,7 +31,7 @@ func prepareCreateRequest(
 		Labels:             mdbcommon.ExpandLabels(ctx, plan.Labels, &diags),
 		HostSpecs:          hostSpecsSlice,
 		ConfigSpec:         expandConfig(ctx, plan.Config, &diags),
-		DeletionProtection: plan.DeletionProtection.ValueBool(),
+		DeletionProtection: mdbcommon.ExpandDeletionProtection(ctx, plan.DeletionProtection, &diags),
 		SecurityGroupIds:   mdbcommon.ExpandSecurityGroupIds(ctx, plan.SecurityGroupIds, &diags),
 		MaintenanceWindow: mdbcommon.ExpandClusterMaintenanceWindow[
 			postgresql.MaintenanceWindow,
@@ -98,7 +98,7 @@ func prepareRestoreRequest(
 		Labels:             mdbcommon.ExpandLabels(ctx, plan.Labels, &diags),
 		HostSpecs:          hostSpecsSlice,
 		ConfigSpec:         expandConfig(ctx, plan.Config, &diags),
-		DeletionProtection: plan.DeletionProtection.ValueBool(),
+		DeletionProtection: mdbcommon.ExpandDeletionProtection(ctx, plan.DeletionProtection, &diags),
 		SecurityGroupIds:   mdbcommon.ExpandSecurityGroupIds(ctx, plan.SecurityGroupIds, &diags),
 		MaintenanceWindow: mdbcommon.ExpandClusterMaintenanceWindow[
 			postgresql.MaintenanceWindow,
@@ -24,7 +24,7 @@ var (
 		"version":                   types.StringType,
 		"resources":                 types.ObjectType{AttrTypes: mdbcommon.ResourceType.AttrTypes},
 		"autofailover":              types.BoolType,
-		"access":                    types.ObjectType{AttrTypes: mdbcommon.AccessAttrTypes},
+		"access":                    types.ObjectType{AttrTypes: mdbcommon.AccessWithYandexQueryAttrTypes},
 		"performance_diagnostics":   types.ObjectType{AttrTypes: expectedPDAttrs},
 		"backup_window_start":       types.ObjectType{AttrTypes: mdbcommon.BackupWindowType.AttrTypes},
 		"backup_retain_period_days": types.Int64Type,
@@ -92,7 +92,7 @@ var (
 			"performance_diagnostics": types.ObjectNull(
 				expectedPDAttrs,
 			),
-			"access": types.ObjectNull(mdbcommon.AccessAttrTypes),
+			"access": types.ObjectNull(mdbcommon.AccessWithYandexQueryAttrTypes),
 			"postgresql_config": NewPgSettingsMapValueMust(map[string]attr.Value{
 				"max_connections": types.Int64Value(100),
 			}),
@@ -148,7 +148,7 @@ func TestYandexProvider_MDBPostgresClusterPrepareCreateRequest(t *testing.T) {
 					"config":              baseConfig,
 					"deletion_protection": types.BoolValue(true),
 					"security_group_ids": types.SetValueMust(types.StringType, []attr.Value{
-						types.StringValue("test-sg"),
+						types.StringValue("enp1sg00000000000001"),
 					}),
 					"restore": types.ObjectValueMust(expectedRestoreAttrTypes, map[string]attr.Value{
 						"backup_id":      types.StringNull(),
@@ -191,7 +191,7 @@ func TestYandexProvider_MDBPostgresClusterPrepareCreateRequest(t *testing.T) {
 						EmergencyUsageThreshold: 20,
 					},
 				},
-				SecurityGroupIds:   []string{"test-sg"},
+				SecurityGroupIds:   []string{"enp1sg00000000000001"},
 				DeletionProtection: true,
 				FolderId:           "test-folder",
 				MaintenanceWindow: &postgresql.MaintenanceWindow{
@@ -339,7 +339,7 @@ func TestYandexProvider_MDBPostgresClusterPrepareRestoreRequest(t *testing.T) {
 					"config":              baseConfig,
 					"deletion_protection": types.BoolValue(true),
 					"security_group_ids": types.SetValueMust(types.StringType, []attr.Value{
-						types.StringValue("test-sg"),
+						types.StringValue("enp1sg00000000000001"),
 					}),
 					"restore": types.ObjectValueMust(expectedRestoreAttrTypes, map[string]attr.Value{
 						"backup_id":      types.StringValue("backup_id"),
@@ -385,7 +385,93 @@ func TestYandexProvider_MDBPostgresClusterPrepareRestoreRequest(t *testing.T) {
 						EmergencyUsageThreshold: 20,
 					},
 				},
-				SecurityGroupIds:   []string{"test-sg"},
+				SecurityGroupIds:   []string{"enp1sg00000000000001"},
+				DeletionProtection: true,
+				FolderId:           "test-folder",
+				MaintenanceWindow: &postgresql.MaintenanceWindow{
+					Policy: &postgresql.MaintenanceWindow_Anytime{
+						Anytime: &postgresql.AnytimeMaintenanceWindow{},
+					},
+				},
+				DiskEncryptionKeyId: wrapperspb.String("test-key"),
+			},
+		},
+		{
+			testname: "CheckRFC3339RestoreTime",
+			reqVal: types.ObjectValueMust(
+				expectedClusterAttrs,
+				map[string]attr.Value{
+					"id": types.StringUnknown(),
+					"hosts": types.MapValueMust(types.StringType, map[string]attr.Value{
+						"host1": types.StringValue("host1"),
+						"host2": types.StringValue("host2"),
+					}),
+					"folder_id":   types.StringValue("test-folder"),
+					"name":        types.StringValue("test-cluster"),
+					"description": types.StringValue("test-description"),
+					"labels": types.MapValueMust(types.StringType, map[string]attr.Value{
+						"key": types.StringValue("value"),
+					}),
+					"environment": types.StringValue("PRESTABLE"),
+					"network_id":  types.StringValue("test-network"),
+					"maintenance_window": types.ObjectValueMust(
+						mdbcommon.MaintenanceWindowType.AttrTypes,
+						map[string]attr.Value{
+							"type": types.StringValue("ANYTIME"),
+							"day":  types.StringValue("MON"),
+							"hour": types.Int64Value(1),
+						},
+					),
+					"config":              baseConfig,
+					"deletion_protection": types.BoolValue(true),
+					"security_group_ids": types.SetValueMust(types.StringType, []attr.Value{
+						types.StringValue("enp1sg00000000000001"),
+					}),
+					"restore": types.ObjectValueMust(expectedRestoreAttrTypes, map[string]attr.Value{
+						"backup_id":      types.StringValue("backup_id"),
+						"time_inclusive": types.BoolValue(true),
+						"time":           types.StringValue("2006-01-02T18:04:05+03:00"),
+					}),
+					"disk_encryption_key_id": types.StringValue("test-key"),
+					"timeouts":               timeouts.Value{},
+				},
+			),
+			expectedVal: &postgresql.RestoreClusterRequest{
+				BackupId:      "backup_id",
+				TimeInclusive: true,
+				Time:          timestamppb.New(parceTime("2006-01-02T15:04:05")),
+				Name:          "test-cluster",
+				Description:   "test-description",
+				Labels: map[string]string{
+					"key": "value",
+				},
+				Environment: postgresql.Cluster_PRESTABLE,
+				NetworkId:   "test-network",
+				ConfigSpec: &postgresql.ConfigSpec{
+					Version: "15",
+					Resources: &postgresql.Resources{
+						ResourcePresetId: "s1.micro",
+						DiskTypeId:       "network-ssd",
+						DiskSize:         datasize.ToBytes(10),
+					},
+					BackupWindowStart: &timeofday.TimeOfDay{},
+					Access:            &postgresql.Access{},
+					PostgresqlConfig: &postgresql.ConfigSpec_PostgresqlConfig_15{
+						PostgresqlConfig_15: &pconfig.PostgresqlConfig15{
+							MaxConnections: wrapperspb.Int64(100),
+						},
+					},
+					PoolerConfig: &postgresql.ConnectionPoolerConfig{
+						PoolingMode: postgresql.ConnectionPoolerConfig_SESSION,
+						PoolDiscard: wrapperspb.Bool(true),
+					},
+					DiskSizeAutoscaling: &postgresql.DiskSizeAutoscaling{
+						DiskSizeLimit:           datasize.ToBytes(5),
+						PlannedUsageThreshold:   20,
+						EmergencyUsageThreshold: 20,
+					},
+				},
+				SecurityGroupIds:   []string{"enp1sg00000000000001"},
 				DeletionProtection: true,
 				FolderId:           "test-folder",
 				MaintenanceWindow: &postgresql.MaintenanceWindow{
@@ -40,17 +40,6 @@ func expandPerformanceDiagnostics(ctx context.Context, pd types.Object, diags *d
 	}
 }
 
-func expandBackupRetainPeriodDays(ctx context.Context, cfgBws types.Int64, diags *diag.Diagnostics) *wrapperspb.Int64Value {
-	var pgBws *wrapperspb.Int64Value
-	if !cfgBws.IsNull() && !cfgBws.IsUnknown() {
-		pgBws = &wrapperspb.Int64Value{
-			Value: cfgBws.ValueInt64(),
-		}
-	}
-
-	return pgBws
-}
-
 func expandBoolWrapper(_ context.Context, b types.Bool, _ *diag.Diagnostics) *wrapperspb.BoolValue {
 	if b.IsNull() || b.IsUnknown() {
 		return nil
@@ -156,7 +145,7 @@ func expandConfig(ctx context.Context, c types.Object, diags *diag.Diagnostics)
 		Autofailover:           expandBoolWrapper(ctx, configSpec.Autofailover, diags),
 		Access:                 mdbcommon.ExpandAccess[postgresql.Access](ctx, configSpec.Access, diags),
 		PerformanceDiagnostics: expandPerformanceDiagnostics(ctx, configSpec.PerformanceDiagnostics, diags),
-		BackupRetainPeriodDays: expandBackupRetainPeriodDays(ctx, configSpec.BackupRetainPeriodDays, diags),
+		BackupRetainPeriodDays: mdbcommon.ExpandRetainPeriod(ctx, configSpec.BackupRetainPeriodDays, diags),
 		BackupWindowStart:      mdbcommon.ExpandBackupWindow(ctx, configSpec.BackupWindowStart, diags),
 		PostgresqlConfig:       expandPostgresqlConfig(ctx, configSpec.Version.ValueString(), configSpec.PostgtgreSQLConfig, diags),
 		PoolerConfig:           expandPoolerConfig(ctx, configSpec.PoolerConfig, diags),
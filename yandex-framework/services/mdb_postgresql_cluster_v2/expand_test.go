@@ -147,7 +147,7 @@ func TestYandexProvider_MDBPostgresClusterConfigBackupRetainPeriodDaysExpand(t *
 
 	for _, c := range cases {
 		diags := diag.Diagnostics{}
-		pgBrpd := expandBackupRetainPeriodDays(ctx, c.reqVal, &diags)
+		pgBrpd := mdbcommon.ExpandRetainPeriod(ctx, c.reqVal, &diags)
 		if diags.HasError() {
 			t.Errorf(
 				"Unexpected expansion diagnostics status %s test: errors: %v",
@@ -533,7 +533,7 @@ func TestYandexProvider_MDBPostgresClusterConfigExpand(t *testing.T) {
 					"backup_window_start":       types.ObjectNull(mdbcommon.BackupWindowType.AttrTypes),
 					"backup_retain_period_days": types.Int64Null(),
 					"autofailover":              types.BoolNull(),
-					"access":                    types.ObjectNull(mdbcommon.AccessAttrTypes),
+					"access":                    types.ObjectNull(mdbcommon.AccessWithYandexQueryAttrTypes),
 					"performance_diagnostics":   types.ObjectNull(expectedPDAttrs),
 					"postgresql_config":         NewPgSettingsMapNull(),
 					"pooler_config":             types.ObjectNull(expectedPCAttrTypes),
@@ -582,12 +582,13 @@ func TestYandexProvider_MDBPostgresClusterConfigExpand(t *testing.T) {
 					"backup_retain_period_days": types.Int64Value(7),
 					"autofailover":              types.BoolValue(true),
 					"access": types.ObjectValueMust(
-						mdbcommon.AccessAttrTypes,
+						mdbcommon.AccessWithYandexQueryAttrTypes,
 						map[string]attr.Value{
 							"web_sql":       types.BoolValue(true),
 							"serverless":    types.BoolValue(false),
 							"data_transfer": types.BoolValue(false),
 							"data_lens":     types.BoolValue(true),
+							"yandex_query":  types.BoolValue(false),
 						},
 					),
 					"performance_diagnostics": types.ObjectValueMust(
@@ -31,13 +31,6 @@ func flattenPerformanceDiagnostics(ctx context.Context, pd *postgresql.Performan
 	return obj
 }
 
-func flattenBackupRetainPeriodDays(ctx context.Context, pgBrpd *wrapperspb.Int64Value, diags *diag.Diagnostics) types.Int64 {
-	if pgBrpd == nil {
-		return types.Int64Null()
-	}
-	return types.Int64Value(pgBrpd.GetValue())
-}
-
 func flattenMapString(ctx context.Context, ms map[string]string, diags *diag.Diagnostics) types.Map {
 	obj, d := types.MapValueFrom(ctx, types.StringType, ms)
 	diags.Append(d...)
@@ -95,7 +88,7 @@ func flattenConfig(ctx context.Context, statePGCfg mdbcommon.SettingsMapValue, c
 		Autofailover:           flattenBoolWrapper(ctx, c.GetAutofailover(), diags),
 		Access:                 mdbcommon.FlattenAccess(ctx, c.Access, diags),
 		PerformanceDiagnostics: flattenPerformanceDiagnostics(ctx, c.PerformanceDiagnostics, diags),
-		BackupRetainPeriodDays: flattenBackupRetainPeriodDays(ctx, c.BackupRetainPeriodDays, diags),
+		BackupRetainPeriodDays: mdbcommon.FlattenRetainPeriod(ctx, c.BackupRetainPeriodDays, diags),
 		BackupWindowStart:      mdbcommon.FlattenBackupWindowStart(ctx, c.BackupWindowStart, diags),
 		PoolerConfig:           flattenPoolerConfig(ctx, c.GetPoolerConfig(), diags),
 		DiskSizeAutoscaling:    flattenDiskSizeAutoscaling(ctx, c.GetDiskSizeAutoscaling(), diags),
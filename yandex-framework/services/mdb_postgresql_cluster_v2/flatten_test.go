@@ -108,7 +108,7 @@ func TestYandexProvider_MDBPostgresClusterConfigBackupRetainPeriodDaysFlattener(
 
 	for _, c := range cases {
 		diags := diag.Diagnostics{}
-		brPd := flattenBackupRetainPeriodDays(ctx, c.reqVal, &diags)
+		brPd := mdbcommon.FlattenRetainPeriod(ctx, c.reqVal, &diags)
 		if diags.HasError() {
 			t.Errorf(
 				"Unexpected flatten diagnostics status %s test: errors: %v",
@@ -616,8 +616,9 @@ func TestYandexProvider_MDBPostgresClusterConfigFlatten(t *testing.T) {
 						"disk_size":          types.Int64Value(10),
 					}),
 					"autofailover": types.BoolValue(true),
-					"access": types.ObjectValueMust(mdbcommon.AccessAttrTypes, map[string]attr.Value{
+					"access": types.ObjectValueMust(mdbcommon.AccessWithYandexQueryAttrTypes, map[string]attr.Value{
 						"data_lens":     types.BoolValue(true),
+						"yandex_query":  types.BoolValue(false),
 						"data_transfer": types.BoolValue(true),
 						"serverless":    types.BoolValue(false),
 						"web_sql":       types.BoolValue(false),
@@ -667,7 +668,7 @@ func TestYandexProvider_MDBPostgresClusterConfigFlatten(t *testing.T) {
 						"disk_size":          types.Int64Value(15),
 					}),
 					"autofailover":              types.BoolNull(),
-					"access":                    types.ObjectNull(mdbcommon.AccessAttrTypes),
+					"access":                    types.ObjectNull(mdbcommon.AccessWithYandexQueryAttrTypes),
 					"performance_diagnostics":   types.ObjectNull(expectedPDAttrs),
 					"backup_window_start":       types.ObjectNull(mdbcommon.BackupWindowType.AttrTypes),
 					"backup_retain_period_days": types.Int64Null(),
@@ -70,7 +70,7 @@ var ConfigAttrTypes = map[string]attr.Type{
 	"version":                   types.StringType,
 	"resources":                 types.ObjectType{AttrTypes: ResourcesAttrTypes},
 	"autofailover":              types.BoolType,
-	"access":                    types.ObjectType{AttrTypes: mdbcommon.AccessAttrTypes},
+	"access":                    types.ObjectType{AttrTypes: mdbcommon.AccessWithYandexQueryAttrTypes},
 	"performance_diagnostics":   types.ObjectType{AttrTypes: PerformanceDiagnosticsAttrTypes},
 	"backup_retain_period_days": types.Int64Type,
 	"backup_window_start":       types.ObjectType{AttrTypes: BackupWindowStartAttrTypes},
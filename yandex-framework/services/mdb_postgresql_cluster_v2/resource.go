@@ -183,7 +183,7 @@ func (r *clusterResource) Schema(ctx context.Context, _ resource.SchemaRequest,
 						},
 					},
 					"time": schema.StringAttribute{
-						Description: "Timestamp of the moment to which the PostgreSQL cluster should be restored. (Format: `2006-01-02T15:04:05` - UTC). When not set, current time is used.",
+						Description: "Timestamp of the moment to which the PostgreSQL cluster should be restored. (Format: `2006-01-02T15:04:05` - UTC or RFC3339). When not set, current time is used.",
 						Optional:    true,
 						PlanModifiers: []planmodifier.String{
 							stringplanmodifier.RequiresReplace(),
@@ -293,6 +293,12 @@ func (r *clusterResource) Schema(ctx context.Context, _ resource.SchemaRequest,
 								Computed:    true,
 								Default:     booldefault.StaticBool(false),
 							},
+							"yandex_query": schema.BoolAttribute{
+								Description: "Allow access for Yandex Query",
+								Optional:    true,
+								Computed:    true,
+								Default:     booldefault.StaticBool(false),
+							},
 						},
 					},
 					"performance_diagnostics": schema.SingleNestedAttribute{
@@ -716,12 +722,12 @@ func (r *clusterResource) refreshResourceState(ctx context.Context, state *Clust
 	state.NetworkId = types.StringValue(cluster.NetworkId)
 	state.Name = types.StringValue(cluster.Name)
 	state.Description = types.StringValue(cluster.Description)
-	state.Environment = types.StringValue(cluster.Environment.String())
+	state.Environment = mdbcommon.FlattenEnvironment(ctx, state.Environment, cluster.GetEnvironment().String(), respDiagnostics)
 	state.Labels = flattenMapString(ctx, cluster.Labels, &diags)
 
 	state.Config = flattenConfig(ctx, cfgState.PostgtgreSQLConfig, cluster.GetConfig(), respDiagnostics)
 
-	state.DeletionProtection = types.BoolValue(cluster.GetDeletionProtection())
+	state.DeletionProtection = mdbcommon.FlattenDeletionProtection(ctx, cluster.GetDeletionProtection(), respDiagnostics)
 	state.MaintenanceWindow = mdbcommon.FlattenMaintenanceWindow[
 		postgresql.MaintenanceWindow,
 		postgresql.WeeklyMaintenanceWindow,
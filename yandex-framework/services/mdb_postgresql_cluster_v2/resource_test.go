@@ -225,6 +225,7 @@ func TestAccMDBPostgreSQLCluster_basic(t *testing.T) {
 					statecheck.ExpectKnownValue(clusterResource, tfjsonpath.New("config").AtMapKey("access"), knownvalue.ObjectExact(
 						map[string]knownvalue.Check{
 							"data_lens":     knownvalue.Bool(false),
+							"yandex_query":  knownvalue.Bool(false),
 							"data_transfer": knownvalue.Bool(false),
 							"web_sql":       knownvalue.Bool(false),
 							"serverless":    knownvalue.Bool(false),
@@ -301,6 +302,7 @@ func TestAccMDBPostgreSQLCluster_basic(t *testing.T) {
 					statecheck.ExpectKnownValue(clusterResource, tfjsonpath.New("config").AtMapKey("access"), knownvalue.ObjectExact(
 						map[string]knownvalue.Check{
 							"data_lens":     knownvalue.Bool(false),
+							"yandex_query":  knownvalue.Bool(false),
 							"data_transfer": knownvalue.Bool(false),
 							"web_sql":       knownvalue.Bool(false),
 							"serverless":    knownvalue.Bool(false),
@@ -425,6 +427,7 @@ func TestAccMDBPostgreSQLCluster_full(t *testing.T) {
 		data_lens = true
 		data_transfer = false
 		web_sql = false
+		yandex_query = true
 	`
 
 	performanceDiagnostics := `
@@ -534,6 +537,7 @@ func TestAccMDBPostgreSQLCluster_full(t *testing.T) {
 					statecheck.ExpectKnownValue(clusterResource, tfjsonpath.New("config").AtMapKey("access"), knownvalue.ObjectExact(
 						map[string]knownvalue.Check{
 							"data_lens":     knownvalue.Bool(false),
+							"yandex_query":  knownvalue.Bool(false),
 							"data_transfer": knownvalue.Bool(true),
 							"web_sql":       knownvalue.Bool(true),
 							"serverless":    knownvalue.Bool(false),
@@ -680,6 +684,7 @@ func TestAccMDBPostgreSQLCluster_full(t *testing.T) {
 					statecheck.ExpectKnownValue(clusterResource, tfjsonpath.New("config").AtMapKey("access"), knownvalue.ObjectExact(
 						map[string]knownvalue.Check{
 							"data_lens":     knownvalue.Bool(true),
+							"yandex_query":  knownvalue.Bool(true),
 							"data_transfer": knownvalue.Bool(false),
 							"web_sql":       knownvalue.Bool(false),
 							"serverless":    knownvalue.Bool(true),
@@ -923,6 +928,7 @@ func TestAccMDBPostgreSQLCluster_mixed(t *testing.T) {
 				statecheck.ExpectKnownValue(clusterResource, tfjsonpath.New("config").AtMapKey("access"), knownvalue.ObjectExact(
 					map[string]knownvalue.Check{
 						"data_lens":     knownvalue.Bool(false),
+						"yandex_query":  knownvalue.Bool(false),
 						"data_transfer": knownvalue.Bool(false),
 						"web_sql":       knownvalue.Bool(false),
 						"serverless":    knownvalue.Bool(false),
@@ -1022,6 +1028,7 @@ func TestAccMDBPostgreSQLCluster_mixed(t *testing.T) {
 				statecheck.ExpectKnownValue(clusterResource, tfjsonpath.New("config").AtMapKey("access"), knownvalue.ObjectExact(
 					map[string]knownvalue.Check{
 						"data_lens":     knownvalue.Bool(false),
+						"yandex_query":  knownvalue.Bool(false),
 						"data_transfer": knownvalue.Bool(false),
 						"web_sql":       knownvalue.Bool(false),
 						"serverless":    knownvalue.Bool(false),
@@ -1115,6 +1122,7 @@ func TestAccMDBPostgreSQLCluster_mixed(t *testing.T) {
 				statecheck.ExpectKnownValue(clusterResource, tfjsonpath.New("config").AtMapKey("access"), knownvalue.ObjectExact(
 					map[string]knownvalue.Check{
 						"data_lens":     knownvalue.Bool(false),
+						"yandex_query":  knownvalue.Bool(false),
 						"data_transfer": knownvalue.Bool(false),
 						"web_sql":       knownvalue.Bool(false),
 						"serverless":    knownvalue.Bool(false),
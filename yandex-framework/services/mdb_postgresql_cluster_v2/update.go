@@ -156,6 +156,7 @@ func prepareConfigChange(ctx context.Context, plan, state *Config) (*postgresql.
 			"config_spec.access.data_lens",
 			"config_spec.access.data_transfer",
 			"config_spec.access.serverless",
+			"config_spec.access.yandex_query",
 		)
 	}
 
@@ -170,7 +171,7 @@ func prepareConfigChange(ctx context.Context, plan, state *Config) (*postgresql.
 	}
 
 	if !plan.BackupRetainPeriodDays.Equal(state.BackupRetainPeriodDays) {
-		config.SetBackupRetainPeriodDays(expandBackupRetainPeriodDays(ctx, plan.BackupRetainPeriodDays, &diags))
+		config.SetBackupRetainPeriodDays(mdbcommon.ExpandRetainPeriod(ctx, plan.BackupRetainPeriodDays, &diags))
 		updateMaskPaths = append(updateMaskPaths, "config_spec.backup_retain_period_days")
 	}
 
@@ -35,7 +35,7 @@ var baseCluster = Cluster{
 	Config:             baseConfig,
 	DeletionProtection: types.BoolValue(true),
 	SecurityGroupIds: types.SetValueMust(types.StringType, []attr.Value{
-		types.StringValue("test-sg"),
+		types.StringValue("enp1sg00000000000001"),
 	}),
 }
 
@@ -52,7 +52,7 @@ func TestYandexProvider_MDBPostgresClusterPrepateUpdateRequestBasic(t *testing.T
 	cluster.Name = types.StringValue("test-cluster-new")
 	cluster.DeletionProtection = types.BoolValue(false)
 	cluster.SecurityGroupIds = types.SetValueMust(types.StringType, []attr.Value{
-		types.StringValue("test-sg-new"),
+		types.StringValue("enp1sg00000000000002"),
 	})
 	cluster.MaintenanceWindow = types.ObjectNull(mdbcommon.MaintenanceWindowType.AttrTypes)
 
@@ -71,7 +71,7 @@ func TestYandexProvider_MDBPostgresClusterPrepateUpdateRequestBasic(t *testing.T
 			Autofailover: wrapperspb.Bool(true),
 		},
 		MaintenanceWindow:  nil,
-		SecurityGroupIds:   []string{"test-sg-new"},
+		SecurityGroupIds:   []string{"enp1sg00000000000002"},
 		DeletionProtection: false,
 		UpdateMask: &fieldmaskpb.FieldMask{
 			Paths: []string{"name", "config_spec.autofailover", "security_group_ids", "deletion_protection", "maintenance_window"},
@@ -83,7 +83,7 @@ func prepareCreateRedisRequest(ctx context.Context, meta *provider_config.Config
 		Sharded:             plan.Sharded.ValueBool(),
 		SecurityGroupIds:    securityGroupIds,
 		TlsEnabled:          &wrappers.BoolValue{Value: plan.TlsEnabled.ValueBool()},
-		DeletionProtection:  plan.DeletionProtection.ValueBool(),
+		DeletionProtection:  mdbcommon.ExpandDeletionProtection(ctx, plan.DeletionProtection, diagnostics),
 		PersistenceMode:     persistenceMode,
 		AnnounceHostnames:   plan.AnnounceHostnames.ValueBool(),
 		MaintenanceWindow:   maintenanceWindow,
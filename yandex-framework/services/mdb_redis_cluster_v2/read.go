@@ -24,7 +24,7 @@ func clusterRead(ctx context.Context, sdk *ycsdk.SDK, diagnostics *diag.Diagnost
 	state.ClusterID = state.ID
 	state.Name = types.StringValue(cluster.Name)
 	state.NetworkID = types.StringValue(cluster.NetworkId)
-	state.Environment = types.StringValue(cluster.GetEnvironment().String())
+	state.Environment = mdbcommon.FlattenEnvironment(ctx, state.Environment, cluster.GetEnvironment().String(), diagnostics)
 	state.Description = types.StringValue(cluster.Description)
 	state.Sharded = types.BoolValue(cluster.Sharded)
 	state.TlsEnabled = types.BoolValue(cluster.TlsEnabled)
@@ -32,7 +32,7 @@ func clusterRead(ctx context.Context, sdk *ycsdk.SDK, diagnostics *diag.Diagnost
 	state.AnnounceHostnames = types.BoolValue(cluster.AnnounceHostnames)
 	state.FolderID = types.StringValue(cluster.FolderId)
 	state.CreatedAt = types.StringValue(timestamp.Get(cluster.CreatedAt))
-	state.DeletionProtection = types.BoolValue(cluster.DeletionProtection)
+	state.DeletionProtection = mdbcommon.FlattenDeletionProtection(ctx, cluster.GetDeletionProtection(), diagnostics)
 	state.AuthSentinel = types.BoolValue(cluster.AuthSentinel)
 	state.DiskEncryptionKeyId = mdbcommon.FlattenStringWrapper(ctx, cluster.DiskEncryptionKeyId, diagnostics)
 
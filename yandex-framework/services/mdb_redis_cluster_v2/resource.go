@@ -99,7 +99,7 @@ func (r *redisClusterResource) Schema(ctx context.Context,
 				PlanModifiers: []planmodifier.String{
 					stringplanmodifier.RequiresReplace(),
 				},
-				Validators:          []validator.String{stringvalidator.OneOf(maps.Keys(redis.Cluster_Environment_value)...)},
+				Validators:          []validator.String{stringvalidator.OneOfCaseInsensitive(maps.Keys(redis.Cluster_Environment_value)...)},
 				MarkdownDescription: "Deployment environment of the Redis cluster.",
 			},
 			"hosts": schema.MapNestedAttribute{
@@ -354,6 +354,7 @@ func (r *redisClusterResource) Schema(ctx context.Context,
 							int64planmodifier.UseStateForUnknown(),
 						},
 						MarkdownDescription: "Replication backlog size as a percentage of flavor maxmemory.",
+						Validators:          []validator.Int64{int64validator.Between(0, 100)},
 					},
 					"cluster_require_full_coverage": schema.BoolAttribute{
 						Optional: true,
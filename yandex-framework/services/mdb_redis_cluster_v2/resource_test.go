@@ -782,6 +782,43 @@ func TestAccMDBRedisClusterV2_sharded(t *testing.T) {
 	})
 }
 
+func TestAccMDBRedisClusterV2_invalidReplBacklogSizePercent(t *testing.T) {
+	t.Parallel()
+
+	redisName := acctest.RandomWithPrefix("tf-redis-repl-backlog")
+	conf := testAccBaseConfig(redisName, "Redis Cluster Terraform Test repl_backlog_size_percent")
+	invalidConfig := func(replBacklogSizePercent int) string {
+		return makeConfig(t, conf, &redisConfigTest{
+			Config: &config{
+				Version:                newPtr("7.2"),
+				Password:               newPtr("12345678PP"),
+				ReplBacklogSizePercent: newPtr(replBacklogSizePercent),
+			},
+			Hosts: map[string]host{
+				"hst_0": {Zone: &defaultZone, SubnetId: &defaultSubnet},
+			},
+		})
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { test.AccPreCheck(t) },
+		ProtoV6ProviderFactories: test.AccProviderFactories,
+		CheckDestroy:             testAccCheckMDBRedisClusterDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config:      invalidConfig(-1),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile(`must\s+be\s+between\s+0\s+and\s+100,\s+got:\s+-1`),
+			},
+			{
+				Config:      invalidConfig(101),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile(`must\s+be\s+between\s+0\s+and\s+100,\s+got:\s+101`),
+			},
+		},
+	})
+}
+
 func TestAccMDBRedisClusterV2_diskEncryption(t *testing.T) {
 	t.Parallel()
 
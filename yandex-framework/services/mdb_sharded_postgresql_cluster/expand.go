@@ -11,29 +11,17 @@ import (
 	protobuf_adapter "github.com/yandex-cloud/terraform-provider-yandex/pkg/adapters/protobuf"
 	"github.com/yandex-cloud/terraform-provider-yandex/pkg/datasize"
 	"github.com/yandex-cloud/terraform-provider-yandex/pkg/mdbcommon"
-	"google.golang.org/protobuf/types/known/wrapperspb"
 )
 
 func expandConfig(ctx context.Context, configSpec Config, diags *diag.Diagnostics) *spqr.ConfigSpec {
 	return &spqr.ConfigSpec{
 		Access:                 mdbcommon.ExpandAccess[spqr.Access](ctx, configSpec.Access, diags),
-		BackupRetainPeriodDays: expandBackupRetainPeriodDays(ctx, configSpec.BackupRetainPeriodDays, diags),
+		BackupRetainPeriodDays: mdbcommon.ExpandRetainPeriod(ctx, configSpec.BackupRetainPeriodDays, diags),
 		BackupWindowStart:      mdbcommon.ExpandBackupWindow(ctx, configSpec.BackupWindowStart, diags),
 		SpqrSpec:               expandSPQRConfig(ctx, configSpec.SPQRConfig, diags),
 	}
 }
 
-func expandBackupRetainPeriodDays(ctx context.Context, cfgBws types.Int64, diags *diag.Diagnostics) *wrapperspb.Int64Value {
-	var bws *wrapperspb.Int64Value
-	if !cfgBws.IsNull() && !cfgBws.IsUnknown() {
-		bws = &wrapperspb.Int64Value{
-			Value: cfgBws.ValueInt64(),
-		}
-	}
-
-	return bws
-}
-
 func expandSPQRConfig(
 	ctx context.Context,
 	config ShardedPostgreSQLConfig,
@@ -10,6 +10,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/yandex-cloud/go-genproto/yandex/cloud/mdb/spqr/v1"
 	"github.com/yandex-cloud/terraform-provider-yandex/pkg/datasize"
+	"github.com/yandex-cloud/terraform-provider-yandex/pkg/mdbcommon"
 	"google.golang.org/protobuf/types/known/wrapperspb"
 )
 
@@ -36,7 +37,7 @@ func TestYandexProvider_MDBSPQRClusterBackupRetainPeriodDaysExpand(t *testing.T)
 
 	for _, c := range cases {
 		diags := &diag.Diagnostics{}
-		res := expandBackupRetainPeriodDays(ctx, c.reqVal, diags)
+		res := mdbcommon.ExpandRetainPeriod(ctx, c.reqVal, diags)
 		if diags.HasError() {
 			t.Errorf(
 				"Unexpected expansion diagnostics status %s test: errors: %v",
@@ -11,7 +11,6 @@ import (
 	protobuf_adapter "github.com/yandex-cloud/terraform-provider-yandex/pkg/adapters/protobuf"
 	"github.com/yandex-cloud/terraform-provider-yandex/pkg/mdbcommon"
 	"google.golang.org/genproto/googleapis/type/timeofday"
-	"google.golang.org/protobuf/types/known/wrapperspb"
 )
 
 func flattenMapString(ctx context.Context, ms map[string]string, diags *diag.Diagnostics) types.Map {
@@ -56,7 +55,7 @@ func flattenConfig(ctx context.Context, cfgState Config, c *spqr.ClusterConfig,
 
 	cfg := &Config{
 		Access:                 mdbcommon.FlattenAccess(ctx, c.Access, diags),
-		BackupRetainPeriodDays: flattenBackupRetainPeriodDays(ctx, c.BackupRetainPeriodDays, diags),
+		BackupRetainPeriodDays: mdbcommon.FlattenRetainPeriod(ctx, c.BackupRetainPeriodDays, diags),
 		BackupWindowStart:      flattenBackupWindowStart(ctx, c.BackupWindowStart, diags),
 		SPQRConfig:             flattenSPQRConfig(ctx, cfgState, c.SpqrConfig, diags),
 	}
@@ -65,13 +64,6 @@ func flattenConfig(ctx context.Context, cfgState Config, c *spqr.ClusterConfig,
 	return obj
 }
 
-func flattenBackupRetainPeriodDays(ctx context.Context, brpd *wrapperspb.Int64Value, diags *diag.Diagnostics) types.Int64 {
-	if brpd == nil {
-		return types.Int64Null()
-	}
-	return types.Int64Value(brpd.GetValue())
-}
-
 func flattenBackupWindowStart(ctx context.Context, bws *timeofday.TimeOfDay, diags *diag.Diagnostics) types.Object {
 	if bws == nil {
 		return types.ObjectNull(BackupWindowStartAttrTypes)
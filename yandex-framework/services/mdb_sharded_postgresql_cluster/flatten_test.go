@@ -114,7 +114,7 @@ func TestYandexProvider_MDBSPQRClusterConfigBackupRetainPeriodDays(t *testing.T)
 
 	for _, c := range cases {
 		diags := diag.Diagnostics{}
-		res := flattenBackupRetainPeriodDays(ctx, c.reqVal, &diags)
+		res := mdbcommon.FlattenRetainPeriod(ctx, c.reqVal, &diags)
 		if diags.HasError() {
 			t.Errorf("Unexpected flatten diagnostics status %s test: errors: %v", c.testname, diags.Errors())
 			continue
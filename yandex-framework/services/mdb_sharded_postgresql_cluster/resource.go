@@ -565,7 +565,7 @@ func (r *clusterResource) refreshResourceState(ctx context.Context, state *Clust
 	state.NetworkId = types.StringValue(cluster.NetworkId)
 	state.Name = types.StringValue(cluster.Name)
 	state.Description = types.StringValue(cluster.Description)
-	state.Environment = types.StringValue(cluster.Environment.String())
+	state.Environment = mdbcommon.FlattenEnvironment(ctx, state.Environment, cluster.GetEnvironment().String(), respDiagnostics)
 	state.Labels = flattenMapString(ctx, cluster.Labels, respDiagnostics)
 	state.DeletionProtection = types.BoolValue(cluster.GetDeletionProtection())
 	state.MaintenanceWindow = flattenMaintenanceWindow(ctx, cluster.MaintenanceWindow, respDiagnostics)
@@ -37,7 +37,7 @@ func prepareConfigChange(ctx context.Context, plan, state *Config) (*spqr.Config
 	}
 
 	if !plan.BackupRetainPeriodDays.Equal(state.BackupRetainPeriodDays) {
-		config.SetBackupRetainPeriodDays(expandBackupRetainPeriodDays(ctx, plan.BackupRetainPeriodDays, &diags))
+		config.SetBackupRetainPeriodDays(mdbcommon.ExpandRetainPeriod(ctx, plan.BackupRetainPeriodDays, &diags))
 		updateMaskPaths = append(updateMaskPaths, "config_spec.backup_retain_period_days")
 	}
 
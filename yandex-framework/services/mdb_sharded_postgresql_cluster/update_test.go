@@ -36,7 +36,7 @@ var (
 		Config:             baseConfig,
 		DeletionProtection: types.BoolValue(true),
 		SecurityGroupIds: types.SetValueMust(types.StringType, []attr.Value{
-			types.StringValue("test-sg"),
+			types.StringValue("enp1sg00000000000001"),
 		}),
 	}
 )
@@ -88,7 +88,7 @@ func TestYandexProvider_MDBSPQRClusterPrepareUpdateRequestBasic(t *testing.T) {
 	cluster.Name = types.StringValue("test-cluster-new")
 	cluster.DeletionProtection = types.BoolValue(false)
 	cluster.SecurityGroupIds = types.SetValueMust(types.StringType, []attr.Value{
-		types.StringValue("test-sg-new"),
+		types.StringValue("enp1sg00000000000002"),
 	})
 	cluster.MaintenanceWindow = types.ObjectNull(expectedMWAttrs)
 
@@ -119,7 +119,7 @@ func TestYandexProvider_MDBSPQRClusterPrepareUpdateRequestBasic(t *testing.T) {
 			},
 		},
 		MaintenanceWindow:  nil,
-		SecurityGroupIds:   []string{"test-sg-new"},
+		SecurityGroupIds:   []string{"enp1sg00000000000002"},
 		DeletionProtection: false,
 		UpdateMask: &fieldmaskpb.FieldMask{
 			Paths: []string{
@@ -77,6 +77,11 @@ func dataSourceYandexCDNOriginGroupRead(d *schema.ResourceData, meta interface{}
 	ctx, cancel := config.ContextWithTimeout(d.Timeout(schema.TimeoutRead))
 	defer cancel()
 
+	err := checkOneOf(d, "origin_group_id", "name")
+	if err != nil {
+		return err
+	}
+
 	folderID, err := getFolderID(d, config)
 	if err != nil {
 		return fmt.Errorf("error getting folder ID while reading CDN origin group: %s", err)
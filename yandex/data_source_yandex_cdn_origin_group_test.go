@@ -2,6 +2,7 @@ package yandex
 
 import (
 	"fmt"
+	"regexp"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
@@ -33,6 +34,11 @@ func TestAccDataSourceCDNOriginGroup_byID(t *testing.T) {
 					resource.TestCheckResourceAttr(cdnDataSourceOriginGroup, "folder_id", folderID),
 					resource.TestCheckResourceAttr(cdnDataSourceOriginGroup, "use_next", "true"),
 					resource.TestCheckResourceAttr(cdnDataSourceOriginGroup, "origin.#", "2"),
+					resource.TestCheckTypeSetElemNestedAttrs(cdnDataSourceOriginGroup, "origin.*", map[string]string{
+						"source":  "ya.ru",
+						"enabled": "true",
+						"backup":  "false",
+					}),
 				),
 			},
 		},
@@ -66,6 +72,22 @@ func TestAccDataSourceCDNOriginGroup_byName(t *testing.T) {
 	})
 }
 
+func TestAccDataSourceCDNOriginGroup_noKeys(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+data "yandex_cdn_origin_group" "test-dev-ds" {
+}
+`,
+				ExpectError: regexp.MustCompile("one of `origin_group_id`, `name` should be provided"),
+			},
+		},
+	})
+}
+
 func testAccDataSourceCDNOriginGroup_byID(groupName string) string {
 	return fmt.Sprintf(`
 data "yandex_cdn_origin_group" "test-dev-ds" {
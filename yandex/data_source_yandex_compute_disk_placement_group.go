@@ -69,6 +69,18 @@ func dataSourceYandexComputeDiskPlacementGroup() *schema.Resource {
 				Description: common.ResourceDescriptions["created_at"],
 				Computed:    true,
 			},
+
+			"placement_strategy_spread": {
+				Type:        schema.TypeBool,
+				Description: "Whether the Disk Placement Group uses the spread placement strategy.",
+				Computed:    true,
+			},
+
+			"placement_strategy_partitions": {
+				Type:        schema.TypeInt,
+				Description: resourceYandexComputeDiskPlacementGroup().Schema["placement_strategy_partitions"].Description,
+				Computed:    true,
+			},
 		},
 	}
 }
@@ -107,6 +119,8 @@ func dataSourceYandexComputeDiskPlacementGroupRead(d *schema.ResourceData, meta
 	d.Set("description", group.Description)
 	d.Set("zone", group.ZoneId)
 	d.Set("status", group.Status.String())
+	d.Set("placement_strategy_spread", group.GetSpreadPlacementStrategy() != nil)
+	d.Set("placement_strategy_partitions", group.GetPartitionPlacementStrategy().GetPartitions())
 
 	if err := d.Set("labels", group.Labels); err != nil {
 		return err
@@ -137,7 +137,7 @@ func TestAccDataSourceComputeImage_byNameAndFolder(t *testing.T) {
 func TestAccDataSourceComputeImage_StandardByFamily(t *testing.T) {
 	t.Parallel()
 
-	family := "ubuntu-1804-lts"
+	family := "ubuntu-2204-lts"
 	re := regexp.MustCompile("ubuntu")
 
 	resource.Test(t, resource.TestCase{
@@ -155,6 +155,12 @@ func TestAccDataSourceComputeImage_StandardByFamily(t *testing.T) {
 					resource.TestMatchResourceAttr("data.yandex_compute_image.by_family",
 						"name", re),
 					testAccCheckCreatedAtAttr("data.yandex_compute_image.by_family"),
+					resource.TestCheckResourceAttr("data.yandex_compute_image.by_family",
+						"os_type", "linux"),
+					resource.TestCheckResourceAttrSet("data.yandex_compute_image.by_family",
+						"min_disk_size"),
+					resource.TestCheckResourceAttrSet("data.yandex_compute_image.by_family",
+						"size"),
 				),
 			},
 		},
@@ -2,6 +2,7 @@ package yandex
 
 import (
 	"fmt"
+	"strings"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"google.golang.org/grpc/codes"
@@ -29,6 +30,12 @@ func dataSourceYandexContainerRepository() *schema.Resource {
 				Optional:    true,
 				Computed:    true,
 			},
+
+			"registry_id": {
+				Type:        schema.TypeString,
+				Description: "The ID of the registry that the repository belongs to.",
+				Computed:    true,
+			},
 		},
 	}
 }
@@ -66,8 +73,19 @@ func dataSourceYandexContainerRepositoryRead(d *schema.ResourceData, meta interf
 
 	d.Set("repository_id", repository.Id)
 	d.Set("name", repository.Name)
+	d.Set("registry_id", containerRepositoryRegistryID(repository.Name))
 
 	d.SetId(repository.Id)
 
 	return nil
 }
+
+// containerRepositoryRegistryID extracts registry ID from the full repository name,
+// which has the form `{registry_id}/{repository_name}`.
+func containerRepositoryRegistryID(repositoryName string) string {
+	registryID, _, found := strings.Cut(repositoryName, "/")
+	if !found {
+		return ""
+	}
+	return registryID
+}
@@ -34,6 +34,7 @@ func TestAccDataSourceContainerRepository_byID(t *testing.T) {
 					testAccCheckResourceIDField("data.yandex_container_repository.source", "repository_id"),
 					testAccCheckDataContainerRepositoryName(&registry, repositoryNameSuffix),
 					resource.TestCheckResourceAttrSet("data.yandex_container_repository.source", "id"),
+					resource.TestCheckResourceAttrPair("data.yandex_container_repository.source", "registry_id", "yandex_container_registry.my-reg", "id"),
 				),
 			},
 		},
@@ -62,12 +63,27 @@ func TestAccDataSourceContainerRepository_byName(t *testing.T) {
 					testAccCheckResourceIDField("data.yandex_container_repository.source", "repository_id"),
 					testAccCheckDataContainerRepositoryName(&registry, repositoryNameSuffix),
 					resource.TestCheckResourceAttrSet("data.yandex_container_repository.source", "id"),
+					resource.TestCheckResourceAttrPair("data.yandex_container_repository.source", "registry_id", "yandex_container_registry.my-reg", "id"),
 				),
 			},
 		},
 	})
 }
 
+func TestContainerRepositoryRegistryID(t *testing.T) {
+	cases := map[string]string{
+		"crp1234567890/my-repo":        "crp1234567890",
+		"crp1234567890/nested/my-repo": "crp1234567890",
+		"my-repo":                      "",
+	}
+
+	for name, expected := range cases {
+		if got := containerRepositoryRegistryID(name); got != expected {
+			t.Errorf("containerRepositoryRegistryID(%q) = %q, expected %q", name, got, expected)
+		}
+	}
+}
+
 func testAccDataSourceContainerRepositoryConfig(registryName, repositoryNameSuffix string, useID bool) string {
 	if useID {
 		return testAccDataSourceContainerRepositoryResourceConfig(registryName, repositoryNameSuffix) + containerRepositoryDataByIDConfig
@@ -0,0 +1,57 @@
+package yandex
+
+import (
+	"strconv"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccDataSourceDataTransferEndpoint_basic(t *testing.T) {
+	t.Parallel()
+
+	templateParams := defaultTemplateParams.
+		withSourceEndpointName("ds-endpoint-src-endpoint" + randomPostfix).
+		withTargetEndpointName("ds-endpoint-dst-endpoint" + randomPostfix).
+		withTransferName("ds-endpoint-transfer" + randomPostfix).
+		withActivateMode(dontActivateMode)
+
+	const byID = "data.yandex_datatransfer_endpoint.by_id"
+	const byName = "data.yandex_datatransfer_endpoint.by_name"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckDataTransferDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceDataTransferEndpointConfig(templateParams),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(byID, "id", sourceEndpointResourceName, "id"),
+					resource.TestCheckResourceAttrPair(byID, "endpoint_id", sourceEndpointResourceName, "id"),
+					resource.TestCheckResourceAttr(byID, "name", templateParams.SourceEndpointName),
+					resource.TestCheckResourceAttr(byID, "description", templateParams.SourceEndpointDescription),
+					resource.TestCheckResourceAttr(byID, "settings.0.postgres_source.0.connection.0.on_premise.0.hosts.0", templateParams.SourceEndpointHostName),
+					resource.TestCheckResourceAttr(byID, "settings.0.postgres_source.0.connection.0.on_premise.0.port", strconv.Itoa(templateParams.SourceEndpointPort)),
+					resource.TestCheckResourceAttr(byID, "settings.0.postgres_source.0.slot_gigabyte_lag_limit", strconv.Itoa(templateParams.SourceEndpointSlotGigabyteLagLimit)),
+
+					resource.TestCheckResourceAttrPair(byName, "endpoint_id", targetEndpointResourceName, "id"),
+					resource.TestCheckResourceAttrPair(byName, "folder_id", targetEndpointResourceName, "folder_id"),
+					resource.TestCheckResourceAttr(byName, "settings.0.postgres_target.0.cleanup_policy", templateParams.CleanupPolicy),
+				),
+			},
+		},
+	})
+}
+
+func testAccDataSourceDataTransferEndpointConfig(templateParams dataTransferTerraformTemplateParams) string {
+	return testAccDataTransferConfigMain(templateParams) + `
+data "yandex_datatransfer_endpoint" "by_id" {
+  endpoint_id = yandex_datatransfer_endpoint.pg_source.id
+}
+
+data "yandex_datatransfer_endpoint" "by_name" {
+  name = yandex_datatransfer_endpoint.pg_target.name
+}
+`
+}
@@ -0,0 +1,96 @@
+package yandex
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/yandex-cloud/go-genproto/yandex/cloud/datatransfer/v1"
+)
+
+func dataSourceYandexDatatransferTransfer() *schema.Resource {
+	dataSource := convertResourceToDataSource(resourceYandexDatatransferTransfer())
+
+	dataSource.Description = "Get information about a Yandex Data Transfer transfer. For more information, see [the official documentation](https://yandex.cloud/docs/data-transfer/concepts/).\n\n~> One of `transfer_id` or `name` should be specified.\n"
+
+	delete(dataSource.Schema, "on_create_activate_mode")
+
+	dataSource.Schema["transfer_id"] = &schema.Schema{
+		Type:        schema.TypeString,
+		Description: "ID of the transfer.",
+		Computed:    true,
+		Optional:    true,
+	}
+	dataSource.Schema["name"].Optional = true
+	dataSource.Schema["folder_id"].Optional = true
+	dataSource.Schema["status"] = &schema.Schema{
+		Type:        schema.TypeString,
+		Description: "Status of the transfer.",
+		Computed:    true,
+	}
+
+	// TODO: SA1019: dataSource.Read is deprecated: Use ReadContext or ReadWithoutTimeout instead. This implementation does not support request cancellation initiated by Terraform, such as a system or practitioner sending SIGINT (Ctrl-c). This implementation also does not support warning diagnostics. (staticcheck)
+	dataSource.Read = dataSourceYandexDatatransferTransferRead
+	return dataSource
+}
+
+func resolveDatatransferTransferID(ctx context.Context, config *Config, d *schema.ResourceData) (string, error) {
+	name := d.Get("name").(string)
+
+	folderID, err := getFolderID(d, config)
+	if err != nil {
+		return "", err
+	}
+
+	iterator := config.sdk.DataTransfer().Transfer().TransferIterator(ctx, &datatransfer.ListTransfersRequest{
+		FolderId: folderID,
+	})
+
+	for iterator.Next() {
+		transfer := iterator.Value()
+		if name == transfer.Name {
+			return transfer.Id, nil
+		}
+	}
+	if err := iterator.Error(); err != nil {
+		return "", err
+	}
+
+	return "", fmt.Errorf("transfer with name %q not found in folder %q", name, folderID)
+}
+
+func dataSourceYandexDatatransferTransferRead(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	ctx := config.Context()
+
+	err := checkOneOf(d, "transfer_id", "name")
+	if err != nil {
+		return err
+	}
+
+	transferID := d.Get("transfer_id").(string)
+	if _, ok := d.GetOk("name"); ok {
+		transferID, err = resolveDatatransferTransferID(ctx, config, d)
+		if err != nil {
+			return fmt.Errorf("failed to resolve data source transfer by name: %v", err)
+		}
+	}
+
+	transfer, err := config.sdk.DataTransfer().Transfer().Get(ctx, &datatransfer.GetTransferRequest{
+		TransferId: transferID,
+	})
+	if err != nil {
+		return handleNotFoundError(err, d, fmt.Sprintf("transfer with ID %q", transferID))
+	}
+
+	d.SetId(transfer.Id)
+	if err := flattenDatatransferTransfer(d, transfer); err != nil {
+		return err
+	}
+
+	d.Set("transfer_id", transfer.Id)
+	d.Set("status", transfer.GetStatus().String())
+
+	return nil
+}
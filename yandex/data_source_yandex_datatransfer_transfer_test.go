@@ -0,0 +1,56 @@
+package yandex
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccDataSourceDataTransferTransfer_basic(t *testing.T) {
+	t.Parallel()
+
+	templateParams := defaultTemplateParams.
+		withSourceEndpointName("ds-datatransfer-src-endpoint" + randomPostfix).
+		withTargetEndpointName("ds-datatransfer-dst-endpoint" + randomPostfix).
+		withTransferName("ds-datatransfer-transfer" + randomPostfix).
+		withActivateMode(dontActivateMode)
+
+	const byID = "data.yandex_datatransfer_transfer.by_id"
+	const byName = "data.yandex_datatransfer_transfer.by_name"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckDataTransferDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceDataTransferTransferConfig(templateParams),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(byID, "id", transferResourceName, "id"),
+					resource.TestCheckResourceAttrPair(byID, "transfer_id", transferResourceName, "id"),
+					resource.TestCheckResourceAttr(byID, "name", templateParams.TransferName),
+					resource.TestCheckResourceAttr(byID, "description", templateParams.TransferDescription),
+					resource.TestCheckResourceAttr(byID, "type", templateParams.TransferType),
+					resource.TestCheckResourceAttrPair(byID, "source_id", sourceEndpointResourceName, "id"),
+					resource.TestCheckResourceAttrPair(byID, "target_id", targetEndpointResourceName, "id"),
+					resource.TestCheckResourceAttrSet(byID, "status"),
+
+					resource.TestCheckResourceAttrPair(byName, "transfer_id", transferResourceName, "id"),
+					resource.TestCheckResourceAttrPair(byName, "folder_id", transferResourceName, "folder_id"),
+				),
+			},
+		},
+	})
+}
+
+func testAccDataSourceDataTransferTransferConfig(templateParams dataTransferTerraformTemplateParams) string {
+	return testAccDataTransferConfigMain(templateParams) + `
+data "yandex_datatransfer_transfer" "by_id" {
+  transfer_id = yandex_datatransfer_transfer.pgpg_transfer.id
+}
+
+data "yandex_datatransfer_transfer" "by_name" {
+  name = yandex_datatransfer_transfer.pgpg_transfer.name
+}
+`
+}
@@ -0,0 +1,73 @@
+package yandex
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/yandex-cloud/go-genproto/yandex/cloud/dns/v1"
+)
+
+func dataSourceYandexDnsRecordSet() *schema.Resource {
+	return &schema.Resource{
+		Description: "Get information about a DNS RecordSet within Yandex Cloud.",
+		Read:        dataSourceYandexDnsRecordSetRead,
+
+		SchemaVersion: 0,
+
+		Schema: map[string]*schema.Schema{
+			"zone_id": {
+				Type:        schema.TypeString,
+				Description: "The id of the zone in which the record set resides.",
+				Required:    true,
+			},
+
+			"name": {
+				Type:        schema.TypeString,
+				Description: resourceYandexDnsRecordSet().Schema["name"].Description,
+				Required:    true,
+			},
+
+			"type": {
+				Type:        schema.TypeString,
+				Description: resourceYandexDnsRecordSet().Schema["type"].Description,
+				Required:    true,
+			},
+
+			"ttl": {
+				Type:        schema.TypeInt,
+				Description: resourceYandexDnsRecordSet().Schema["ttl"].Description,
+				Computed:    true,
+			},
+
+			"data": {
+				Type:        schema.TypeList,
+				Description: "The string data for the records in this record set.",
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+		},
+	}
+}
+
+func dataSourceYandexDnsRecordSetRead(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	sdk := getSDK(config)
+
+	zoneID := d.Get("zone_id").(string)
+	name := d.Get("name").(string)
+	rsType := d.Get("type").(string)
+
+	rs, err := sdk.DNS().DnsZone().GetRecordSet(config.Context(), &dns.GetDnsZoneRecordSetRequest{
+		DnsZoneId: zoneID,
+		Name:      name,
+		Type:      rsType,
+	})
+	if err != nil {
+		return handleNotFoundError(err, d, fmt.Sprintf("DnsRecordSet %s %s", rsType, name))
+	}
+
+	d.Set("ttl", int(rs.Ttl))
+	d.SetId(fmt.Sprintf("%s/%s/%s", zoneID, name, rsType))
+
+	return d.Set("data", rs.Data)
+}
@@ -0,0 +1,47 @@
+package yandex
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccDataSourceDNSRecordSet_basic(t *testing.T) {
+	t.Parallel()
+
+	zoneName := acctest.RandomWithPrefix("tf-dns-zone")
+	fqdn := acctest.RandomWithPrefix("tf-test") + ".dnstest.test."
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckDnsZoneDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceDnsRecordSetConfig(zoneName, fqdn),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair("data.yandex_dns_recordset.rs", "zone_id", "yandex_dns_zone.zone1", "id"),
+					resource.TestCheckResourceAttr("data.yandex_dns_recordset.rs", "name", "srv."+fqdn),
+					resource.TestCheckResourceAttr("data.yandex_dns_recordset.rs", "type", "A"),
+					resource.TestCheckResourceAttr("data.yandex_dns_recordset.rs", "ttl", "200"),
+					resource.TestCheckResourceAttr("data.yandex_dns_recordset.rs", "data.#", "2"),
+					resource.TestCheckTypeSetElemAttr("data.yandex_dns_recordset.rs", "data.*", "192.168.0.1"),
+					resource.TestCheckTypeSetElemAttr("data.yandex_dns_recordset.rs", "data.*", "192.168.0.2"),
+				),
+			},
+		},
+	})
+}
+
+const dnsRecordSetDataConfig = `
+data "yandex_dns_recordset" "rs" {
+  zone_id = yandex_dns_zone.zone1.id
+  name    = yandex_dns_recordset.rs1.name
+  type    = yandex_dns_recordset.rs1.type
+}
+`
+
+func testAccDataSourceDnsRecordSetConfig(name, fqdn string) string {
+	return testAccDNSRecordSetBasic(name, fqdn) + dnsRecordSetDataConfig
+}
@@ -225,6 +225,12 @@ func dataSourceYandexFunction() *schema.Resource {
 				Computed:    true,
 			},
 
+			"http_invoke_url": {
+				Type:        schema.TypeString,
+				Description: resourceYandexFunction().Schema["http_invoke_url"].Description,
+				Computed:    true,
+			},
+
 			"connectivity": {
 				Type:     schema.TypeList,
 				MaxItems: 1,
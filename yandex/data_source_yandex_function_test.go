@@ -36,6 +36,7 @@ func TestAccDataSourceYandexFunction_byID(t *testing.T) {
 					resource.TestCheckResourceAttr(functionDataSource, "description", functionDesc),
 					resource.TestCheckResourceAttrSet(functionDataSource, "folder_id"),
 					testAccCheckCreatedAtAttr(functionDataSource),
+					resource.TestCheckResourceAttrPair(functionDataSource, "http_invoke_url", functionResource, "http_invoke_url"),
 				),
 			},
 		},
@@ -64,6 +65,7 @@ func TestAccDataSourceYandexFunction_byName(t *testing.T) {
 					resource.TestCheckResourceAttr(functionDataSource, "description", functionDesc),
 					resource.TestCheckResourceAttrSet(functionDataSource, "folder_id"),
 					testAccCheckCreatedAtAttr(functionDataSource),
+					resource.TestCheckResourceAttrPair(functionDataSource, "http_invoke_url", functionResource, "http_invoke_url"),
 				),
 			},
 		},
@@ -0,0 +1,104 @@
+package yandex
+
+import (
+	"context"
+	"sort"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func dataSourceYandexIAMServiceAccountIAMPolicy() *schema.Resource {
+	return &schema.Resource{
+		Description: "Get the current IAM policy of a Yandex IAM service account. The data source is read-only and may be used to audit access bindings set on the service account by `yandex_iam_service_account_iam_policy`, `yandex_iam_service_account_iam_binding` or `yandex_iam_service_account_iam_member` resources.\n",
+
+		ReadContext: dataSourceYandexIAMServiceAccountIAMPolicyRead,
+
+		Schema: map[string]*schema.Schema{
+			"service_account_id": {
+				Type:        schema.TypeString,
+				Description: "ID of the service account to get the IAM policy of.",
+				Required:    true,
+			},
+
+			"policy_data": {
+				Type:        schema.TypeString,
+				Description: "The IAM policy of the service account serialized in a format suitable for referencing from a `yandex_iam_service_account_iam_policy` resource.",
+				Computed:    true,
+			},
+
+			"bindings": {
+				Type:        schema.TypeList,
+				Description: "The list of access bindings of the service account grouped by role.",
+				Computed:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"role": {
+							Type:        schema.TypeString,
+							Description: "The role that is granted to the members.",
+							Computed:    true,
+						},
+						"members": {
+							Type:        schema.TypeList,
+							Description: "Identities that are granted the `role`, in the `{type}:{id}` format.",
+							Computed:    true,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceYandexIAMServiceAccountIAMPolicyRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*Config)
+
+	updater, err := newServiceAccountIamUpdater(d, config)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	policy, err := updater.GetResourceIamPolicy(ctx)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(updater.GetResourceID())
+
+	if err := d.Set("policy_data", marshalIamPolicy(policy)); err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err := d.Set("bindings", flattenIamPolicyBindings(policy)); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
+
+func flattenIamPolicyBindings(policy *Policy) []interface{} {
+	roleMembers := rolesToMembersMap(policy.Bindings)
+
+	roles := make([]string, 0, len(roleMembers))
+	for role := range roleMembers {
+		roles = append(roles, role)
+	}
+	sort.Strings(roles)
+
+	bindings := make([]interface{}, 0, len(roles))
+	for _, role := range roles {
+		members := make([]string, 0, len(roleMembers[role]))
+		for member := range roleMembers[role] {
+			members = append(members, member)
+		}
+		sort.Strings(members)
+
+		bindings = append(bindings, map[string]interface{}{
+			"role":    role,
+			"members": members,
+		})
+	}
+
+	return bindings
+}
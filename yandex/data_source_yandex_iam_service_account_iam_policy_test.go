@@ -0,0 +1,88 @@
+package yandex
+
+import (
+	"fmt"
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+
+	"github.com/yandex-cloud/go-genproto/yandex/cloud/access"
+)
+
+func TestAccDataSourceYandexIAMServiceAccountIAMPolicy_basic(t *testing.T) {
+	serviceAccountName := acctest.RandomWithPrefix("tf-test")
+	cloudID := getExampleCloudID()
+	userID := getExampleUserID1()
+	role := "editor"
+	dataSourceName := "data.yandex_iam_service_account_iam_policy.bar"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProviderFactoriesV6,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataServiceAccountIamPolicy(cloudID, serviceAccountName, role, userID),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(dataSourceName, "service_account_id",
+						"yandex_iam_service_account.test_account", "id"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "policy_data"),
+					resource.TestCheckResourceAttr(dataSourceName, "bindings.#", "1"),
+					resource.TestCheckResourceAttr(dataSourceName, "bindings.0.role", role),
+					resource.TestCheckResourceAttr(dataSourceName, "bindings.0.members.#", "1"),
+					resource.TestCheckResourceAttr(dataSourceName, "bindings.0.members.0", "userAccount:"+userID),
+				),
+			},
+		},
+	})
+}
+
+func TestFlattenIamPolicyBindings(t *testing.T) {
+	policy := &Policy{
+		Bindings: []*access.AccessBinding{
+			roleMemberToAccessBinding("viewer", "userAccount:user2"),
+			roleMemberToAccessBinding("editor", "serviceAccount:sa1"),
+			roleMemberToAccessBinding("viewer", "userAccount:user1"),
+		},
+	}
+
+	expected := []interface{}{
+		map[string]interface{}{
+			"role":    "editor",
+			"members": []string{"serviceAccount:sa1"},
+		},
+		map[string]interface{}{
+			"role":    "viewer",
+			"members": []string{"userAccount:user1", "userAccount:user2"},
+		},
+	}
+
+	if got := flattenIamPolicyBindings(policy); !reflect.DeepEqual(got, expected) {
+		t.Fatalf("unexpected flattened bindings: expected %v, got %v", expected, got)
+	}
+}
+
+func testAccDataServiceAccountIamPolicy(cloudID, accountName, role, userID string) string {
+	prerequisiteMembership, deps := testAccCloudAssignCloudMemberRole(cloudID, userID)
+	return prerequisiteMembership + fmt.Sprintf(`
+resource "yandex_iam_service_account" "test_account" {
+  name        = "%s"
+  description = "Iam Testing Account"
+}
+
+resource "yandex_iam_service_account_iam_member" "foo" {
+  service_account_id = "${yandex_iam_service_account.test_account.id}"
+  role               = "%s"
+  member             = "userAccount:%s"
+
+  depends_on = [%s]
+}
+
+data "yandex_iam_service_account_iam_policy" "bar" {
+  service_account_id = "${yandex_iam_service_account.test_account.id}"
+
+  depends_on = [yandex_iam_service_account_iam_member.foo]
+}
+`, accountName, role, userID, deps)
+}
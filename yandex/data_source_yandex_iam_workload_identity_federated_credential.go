@@ -64,7 +64,7 @@ func dataSourceYandexIAMWorkloadIdentityFederatedCredentialRead(ctx context.Cont
 	resp, err := config.sdk.Workload().FederatedCredential().Get(ctx, req)
 
 	if err != nil {
-		diag.FromErr(err)
+		return diag.FromErr(err)
 	}
 
 	d.SetId(resp.Id)
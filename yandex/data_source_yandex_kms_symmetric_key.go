@@ -70,6 +70,7 @@ func dataSourceYandexKMSSymmetricKey() *schema.Resource {
 				Type:             schema.TypeString,
 				Description:      resourceYandexKMSSymmetricKey().Schema["rotation_period"].Description,
 				Optional:         true,
+				Computed:         true,
 				ValidateFunc:     validateParsableValue(parsePositiveDuration),
 				DiffSuppressFunc: shouldSuppressDiffForTimeDuration,
 			},
@@ -91,6 +92,13 @@ func dataSourceYandexKMSSymmetricKey() *schema.Resource {
 				Description: common.ResourceDescriptions["created_at"],
 				Computed:    true,
 			},
+
+			"current_primary_version_id": {
+				Type:        schema.TypeString,
+				Description: "ID of the current primary version of the key.",
+				Computed:    true,
+			},
+
 			"symmetric_key_id": {
 				Type:         schema.TypeString,
 				Description:  "The symmetric key ID.",
@@ -134,6 +142,7 @@ func dataSourceYandexKMSSymmetricKeyRead(ctx context.Context, data *schema.Resou
 	rotatedAt := getTimestamp(resp.GetRotatedAt())
 
 	data.Set("created_at", createdAt)
+	data.Set("current_primary_version_id", resp.GetPrimaryVersion().GetId())
 	data.Set("default_algorithm", resp.GetDefaultAlgorithm().String())
 	data.Set("deletion_protection", resp.GetDeletionProtection())
 	data.Set("description", resp.GetDescription())
@@ -143,6 +152,7 @@ func dataSourceYandexKMSSymmetricKeyRead(ctx context.Context, data *schema.Resou
 	}
 	data.Set("name", resp.GetName())
 	data.Set("rotated_at", rotatedAt)
+	data.Set("rotation_period", formatDuration(resp.GetRotationPeriod()))
 	data.Set("status", resp.GetStatus().String())
 	data.Set("symmetric_key_id", resp.GetId())
 
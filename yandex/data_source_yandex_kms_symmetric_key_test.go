@@ -36,6 +36,9 @@ func TestAccDataSourceKMSSymmetricKey_basic(t *testing.T) {
 					resource.TestCheckResourceAttr(basicData, "labels.%", "2"),
 					resource.TestCheckResourceAttr(basicData, "labels.key1", "value1"),
 					resource.TestCheckResourceAttr(basicData, "labels.key2", "value2"),
+					resource.TestCheckResourceAttrPair(basicData, "rotation_period", "yandex_kms_symmetric_key.basic_key", "rotation_period"),
+					resource.TestCheckResourceAttr(basicData, "status", "ACTIVE"),
+					resource.TestCheckResourceAttrSet(basicData, "current_primary_version_id"),
 					testAccCheckCreatedAtAttr(basicData),
 					// same checks, now for the key obtained by name
 					testAccDataSourceKmsSymmetricKeyExists(basicDataByName),
@@ -47,6 +50,9 @@ func TestAccDataSourceKMSSymmetricKey_basic(t *testing.T) {
 					resource.TestCheckResourceAttr(basicDataByName, "labels.%", "2"),
 					resource.TestCheckResourceAttr(basicDataByName, "labels.key1", "value1"),
 					resource.TestCheckResourceAttr(basicDataByName, "labels.key2", "value2"),
+					resource.TestCheckResourceAttrPair(basicDataByName, "rotation_period", "yandex_kms_symmetric_key.basic_key", "rotation_period"),
+					resource.TestCheckResourceAttr(basicDataByName, "status", "ACTIVE"),
+					resource.TestCheckResourceAttrSet(basicDataByName, "current_primary_version_id"),
 					testAccCheckCreatedAtAttr(basicDataByName),
 				),
 			},
@@ -63,6 +69,7 @@ resource "yandex_kms_symmetric_key" "basic_key" {
     key1 = "value1"
     key2 = "value2"
   }
+  rotation_period = "24h"
 }
 
 data "yandex_kms_symmetric_key" "basic_key" {
@@ -12,7 +12,7 @@ import (
 
 func dataSourceYandexKubernetesNodeGroup() *schema.Resource {
 	return &schema.Resource{
-		Description: "Get information about a Yandex Kubernetes Node Group. For more information, see [the official documentation](https://yandex.cloud/docs/managed-kubernetes/concepts/#node-group).\n\n~> One of `node_group_id` or `name` should be specified.\n",
+		Description: "Get information about a Yandex Kubernetes Node Group. For more information, see [the official documentation](https://yandex.cloud/docs/managed-kubernetes/concepts/#node-group).\n\n~> One of `node_group_id` or `name` should be specified. When `cluster_id` is specified together with `name`, the node group is looked up within the cluster instead of the folder.\n",
 
 		Read: dataSourceYandexKubernetesNodeGroupRead,
 		Schema: map[string]*schema.Schema{
@@ -35,9 +35,11 @@ func dataSourceYandexKubernetesNodeGroup() *schema.Resource {
 				Computed:    true,
 			},
 			"cluster_id": {
-				Type:        schema.TypeString,
-				Description: resourceYandexKubernetesNodeGroup().Schema["cluster_id"].Description,
-				Computed:    true,
+				Type:          schema.TypeString,
+				Description:   resourceYandexKubernetesNodeGroup().Schema["cluster_id"].Description,
+				Optional:      true,
+				Computed:      true,
+				ConflictsWith: []string{"node_group_id"},
 			},
 			"created_at": {
 				Type:        schema.TypeString,
@@ -463,7 +465,14 @@ func dataSourceYandexKubernetesNodeGroupRead(d *schema.ResourceData, meta interf
 	nodeGroupID := d.Get("node_group_id").(string)
 	_, nodeGroupNameOk := d.GetOk("name")
 
-	if nodeGroupNameOk {
+	clusterID, clusterIDOk := d.GetOk("cluster_id")
+
+	if nodeGroupNameOk && clusterIDOk {
+		nodeGroupID, err = resolveKubernetesNodeGroupIDInCluster(ctx, config, d.Get("name").(string), clusterID.(string))
+		if err != nil {
+			return fmt.Errorf("failed to resolve data source node-group by name in cluster %q: %v", clusterID, err)
+		}
+	} else if nodeGroupNameOk {
 		nodeGroupID, err = resolveObjectID(ctx, config, d, sdkresolvers.KubernetesNodeGroupResolver)
 		if err != nil {
 			return fmt.Errorf("failed to resolve data source node-group by name: %v", err)
@@ -486,3 +495,23 @@ func dataSourceYandexKubernetesNodeGroupRead(d *schema.ResourceData, meta interf
 	d.Set("node_group_id", ng.Id)
 	return nil
 }
+
+func resolveKubernetesNodeGroupIDInCluster(ctx context.Context, config *Config, name, clusterID string) (string, error) {
+	resp, err := config.sdk.Kubernetes().Cluster().ListNodeGroups(ctx, &k8s.ListClusterNodeGroupsRequest{
+		ClusterId: clusterID,
+		Filter:    fmt.Sprintf("name = %q", name),
+	})
+	if err != nil {
+		return "", err
+	}
+
+	nodeGroups := resp.GetNodeGroups()
+	if len(nodeGroups) == 0 {
+		return "", fmt.Errorf("node-group with name %q not found", name)
+	}
+	if len(nodeGroups) > 1 {
+		return "", fmt.Errorf("multiple node-groups with name %q found", name)
+	}
+
+	return nodeGroups[0].GetId(), nil
+}
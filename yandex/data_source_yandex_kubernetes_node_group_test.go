@@ -263,6 +263,32 @@ func TestAccDataSourceKubernetesNodeGroupContainerNetwork_basic(t *testing.T) {
 	})
 }
 
+func TestAccDataSourceKubernetesNodeGroup_byNameInCluster(t *testing.T) {
+	clusterResource := clusterInfo("TestAccDataSourceKubernetesNodeGroup_byNameInCluster", true)
+	nodeResource := nodeGroupInfo(clusterResource.ClusterResourceName)
+	nodeResourceFullName := nodeResource.ResourceFullName(false)
+
+	var ng k8s.NodeGroup
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProviderFactoriesV6,
+		CheckDestroy:             testAccCheckKubernetesNodeGroupDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceKubernetesNodeGroupConfig_byNameInCluster(clusterResource, nodeResource),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckKubernetesNodeGroupExists(nodeResourceFullName, &ng),
+					checkNodeGroupAttributes(&ng, &nodeResource, false, false),
+					testAccCheckResourceIDField(nodeResourceFullName, "node_group_id"),
+					resource.TestCheckResourceAttrPair(nodeResourceFullName, "cluster_id",
+						nodeResource.ResourceFullName(true), "cluster_id"),
+				),
+			},
+		},
+	})
+}
+
 const dataNodeGroupConfigTemplate = `
 data "yandex_kubernetes_node_group" "{{.NodeGroupResourceName}}" {
   name = "${yandex_kubernetes_node_group.{{.NodeGroupResourceName}}.name}"
@@ -274,3 +300,16 @@ func testAccDataSourceKubernetesNodeGroupConfig_basic(cluster resourceClusterInf
 	resourceConfig += templateConfig(dataNodeGroupConfigTemplate, ng.Map())
 	return resourceConfig
 }
+
+const dataNodeGroupInClusterConfigTemplate = `
+data "yandex_kubernetes_node_group" "{{.NodeGroupResourceName}}" {
+  name       = "${yandex_kubernetes_node_group.{{.NodeGroupResourceName}}.name}"
+  cluster_id = "${yandex_kubernetes_node_group.{{.NodeGroupResourceName}}.cluster_id}"
+}
+`
+
+func testAccDataSourceKubernetesNodeGroupConfig_byNameInCluster(cluster resourceClusterInfo, ng resourceNodeGroupInfo) string {
+	resourceConfig := testAccKubernetesNodeGroupConfig_basic(cluster, ng)
+	resourceConfig += templateConfig(dataNodeGroupInClusterConfigTemplate, ng.Map())
+	return resourceConfig
+}
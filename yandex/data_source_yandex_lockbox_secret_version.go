@@ -42,6 +42,12 @@ func dataSourceYandexLockboxSecretVersion() *schema.Resource {
 							Computed:  true,
 							Sensitive: true,
 						},
+
+						"binary_value": {
+							Type:      schema.TypeString,
+							Computed:  true,
+							Sensitive: true,
+						},
 					},
 				},
 				Computed: true,
@@ -49,7 +55,7 @@ func dataSourceYandexLockboxSecretVersion() *schema.Resource {
 
 			"version_id": {
 				Type:        schema.TypeString,
-				Description: "The Yandex Cloud Lockbox secret version ID.",
+				Description: "The Yandex Cloud Lockbox secret version ID. If not set, the current version of the secret is used.",
 				Optional:    true,
 				Computed:    true,
 			},
@@ -74,6 +80,10 @@ func dataSourceYandexLockboxSecretVersionRead(ctx context.Context, d *schema.Res
 	}
 
 	d.SetId(payload.VersionId)
+	// version_id may be omitted to read the current version, so store the resolved one
+	if err := d.Set("version_id", payload.VersionId); err != nil {
+		return diag.FromErr(err)
+	}
 
 	entries, err := flattenLockboxSecretVersionEntriesSlice(payload.GetEntries())
 	if err != nil {
@@ -85,7 +95,7 @@ func dataSourceYandexLockboxSecretVersionRead(ctx context.Context, d *schema.Res
 		return diag.FromErr(err)
 	}
 
-	log.Printf("[INFO] read Lockbox version with ID: %s", id)
+	log.Printf("[INFO] read Lockbox version with ID: %s", payload.VersionId)
 
 	return diag.FromErr(err)
 }
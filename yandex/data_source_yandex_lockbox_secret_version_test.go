@@ -16,6 +16,7 @@ func TestAccDataSourceLockboxVersion_basic(t *testing.T) {
 	secretName := "a" + acctest.RandString(10)
 	basicData1 := "data.yandex_lockbox_secret_version.basic_version1"
 	basicData2 := "data.yandex_lockbox_secret_version.basic_version2"
+	currentData := "data.yandex_lockbox_secret_version.current_version"
 	resource.Test(t, resource.TestCase{
 		PreCheck:          func() { testAccPreCheck(t) },
 		ProviderFactories: testAccProviderFactories,
@@ -43,6 +44,19 @@ func TestAccDataSourceLockboxVersion_basic(t *testing.T) {
 					}),
 				),
 			},
+			{
+				// Omitted version_id resolves to the current version
+				Config: testAccLockboxSecretVersionKeyResource(secretName, BASIC_VERSION2_RESOURCE_AND_DATA+CURRENT_VERSION_DATA),
+				Check: resource.ComposeTestCheckFunc(
+					testAccDataSourceLockboxSecretVersionExists(currentData),
+					testAccCheckResourceIDField(currentData, "version_id"),
+					resource.TestCheckResourceAttrPair(currentData, "version_id", "yandex_lockbox_secret_version.basic_version2", "id"),
+					testAccCheckYandexLockboxVersionStateEntries(currentData, []*lockboxEntryCheck{
+						{Key: "key2", Val: "val2"},
+						{Key: "key3", Val: "val3"},
+					}),
+				),
+			},
 		},
 	})
 }
@@ -92,6 +106,13 @@ data "yandex_lockbox_secret_version" "basic_version2" {
 }
 `
 
+const CURRENT_VERSION_DATA = `
+data "yandex_lockbox_secret_version" "current_version" {
+  secret_id  = yandex_lockbox_secret.basic_secret.id
+  depends_on = [yandex_lockbox_secret_version.basic_version2]
+}
+`
+
 func testAccDataSourceLockboxSecretVersionExists(name string) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		ds, ok := s.RootModule().Resources[name]
@@ -227,6 +227,10 @@ func testAccDataSourceMDBMysqlClusterAttributesCheck(datasourceName string, reso
 				"backup_retain_period_days",
 				"backup_retain_period_days",
 			},
+			{
+				"access.0.yandex_query",
+				"access.0.yandex_query",
+			},
 		}
 
 		for _, attrToCheck := range instanceAttrsToTest {
@@ -270,6 +274,7 @@ func testAccDataSourceMDBMysqlClusterCheck(datasourceName string, resourceName s
 		resource.TestCheckResourceAttr(datasourceName, "security_group_ids.#", "1"),
 		resource.TestCheckResourceAttr(datasourceName, "deletion_protection", "false"),
 		resource.TestCheckResourceAttr(datasourceName, "backup_retain_period_days", "12"),
+		resource.TestCheckResourceAttr(datasourceName, "access.0.yandex_query", "false"),
 	)
 }
 
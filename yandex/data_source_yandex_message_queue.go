@@ -52,6 +52,46 @@ func dataSourceYandexMessageQueue() *schema.Resource {
 				Description: "URL of the queue.",
 				Computed:    true,
 			},
+			"fifo_queue": {
+				Type:        schema.TypeBool,
+				Description: resourceYandexMessageQueue().Schema["fifo_queue"].Description,
+				Computed:    true,
+			},
+			"content_based_deduplication": {
+				Type:        schema.TypeBool,
+				Description: resourceYandexMessageQueue().Schema["content_based_deduplication"].Description,
+				Computed:    true,
+			},
+			"delay_seconds": {
+				Type:        schema.TypeInt,
+				Description: resourceYandexMessageQueue().Schema["delay_seconds"].Description,
+				Computed:    true,
+			},
+			"visibility_timeout_seconds": {
+				Type:        schema.TypeInt,
+				Description: resourceYandexMessageQueue().Schema["visibility_timeout_seconds"].Description,
+				Computed:    true,
+			},
+			"message_retention_seconds": {
+				Type:        schema.TypeInt,
+				Description: resourceYandexMessageQueue().Schema["message_retention_seconds"].Description,
+				Computed:    true,
+			},
+			"max_message_size": {
+				Type:        schema.TypeInt,
+				Description: resourceYandexMessageQueue().Schema["max_message_size"].Description,
+				Computed:    true,
+			},
+			"receive_wait_time_seconds": {
+				Type:        schema.TypeInt,
+				Description: resourceYandexMessageQueue().Schema["receive_wait_time_seconds"].Description,
+				Computed:    true,
+			},
+			"redrive_policy": {
+				Type:        schema.TypeString,
+				Description: resourceYandexMessageQueue().Schema["redrive_policy"].Description,
+				Computed:    true,
+			},
 		},
 	}
 }
@@ -96,7 +136,7 @@ func dataSourceYandexMessageQueueRead(d *schema.ResourceData, meta interface{})
 	err = resource.Retry(15*time.Second, func() *resource.RetryError {
 		attributesOutput, err = ymqClient.GetQueueAttributes(&sqs.GetQueueAttributesInput{
 			QueueUrl:       aws.String(queueURL),
-			AttributeNames: []*string{aws.String(sqs.QueueAttributeNameQueueArn)},
+			AttributeNames: []*string{aws.String(sqs.QueueAttributeNameAll)},
 		})
 
 		if err != nil {
@@ -113,7 +153,12 @@ func dataSourceYandexMessageQueueRead(d *schema.ResourceData, meta interface{})
 		return fmt.Errorf("Error getting queue attributes: %s", err)
 	}
 
-	d.Set("arn", aws.StringValue(attributesOutput.Attributes[sqs.QueueAttributeNameQueueArn]))
+	queueAttributes := aws.StringValueMap(attributesOutput.Attributes)
+
+	d.Set("arn", queueAttributes[sqs.QueueAttributeNameQueueArn])
+	if err := flattenMessageQueueAttributes(d, queueAttributes); err != nil {
+		return err
+	}
 	d.Set("url", queueURL)
 	d.SetId(queueURL)
 
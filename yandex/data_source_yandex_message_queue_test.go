@@ -4,11 +4,51 @@ import (
 	"fmt"
 	"testing"
 
+	"github.com/aws/aws-sdk-go/service/sqs"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
+func TestFlattenMessageQueueAttributes(t *testing.T) {
+	queueAttributes := map[string]string{
+		sqs.QueueAttributeNameFifoQueue:                     "true",
+		sqs.QueueAttributeNameContentBasedDeduplication:     "true",
+		sqs.QueueAttributeNameDelaySeconds:                  "5",
+		sqs.QueueAttributeNameMaximumMessageSize:            "1024",
+		sqs.QueueAttributeNameMessageRetentionPeriod:        "86400",
+		sqs.QueueAttributeNameReceiveMessageWaitTimeSeconds: "10",
+		sqs.QueueAttributeNameVisibilityTimeout:             "60",
+		sqs.QueueAttributeNameRedrivePolicy:                 `{"deadLetterTargetArn":"yrn:yc:ymq:ru-central1:folder:dlq","maxReceiveCount":3}`,
+		sqs.QueueAttributeNameKmsMasterKeyId:                "key-id",
+		sqs.QueueAttributeNameKmsDataKeyReusePeriodSeconds:  "300",
+		sqs.QueueAttributeNamePolicy:                        "{}",
+	}
+
+	for name, r := range map[string]*schema.Resource{
+		"resource":    resourceYandexMessageQueue(),
+		"data source": dataSourceYandexMessageQueue(),
+	} {
+		t.Run(name, func(t *testing.T) {
+			d := r.TestResourceData()
+
+			require.NoError(t, flattenMessageQueueAttributes(d, queueAttributes))
+
+			assert.Equal(t, true, d.Get("fifo_queue"))
+			assert.Equal(t, true, d.Get("content_based_deduplication"))
+			assert.Equal(t, 5, d.Get("delay_seconds"))
+			assert.Equal(t, 1024, d.Get("max_message_size"))
+			assert.Equal(t, 86400, d.Get("message_retention_seconds"))
+			assert.Equal(t, 10, d.Get("receive_wait_time_seconds"))
+			assert.Equal(t, 60, d.Get("visibility_timeout_seconds"))
+			assert.Equal(t, queueAttributes[sqs.QueueAttributeNameRedrivePolicy], d.Get("redrive_policy"))
+		})
+	}
+}
+
 func TestAccDataSourceYandexMessageQueue_basic(t *testing.T) {
 	var randInt int = acctest.RandInt()
 	resourceName := "yandex_message_queue.test"
@@ -26,6 +66,30 @@ func TestAccDataSourceYandexMessageQueue_basic(t *testing.T) {
 	})
 }
 
+func TestAccDataSourceYandexMessageQueue_fifo(t *testing.T) {
+	var randInt int = acctest.RandInt()
+	resourceName := "yandex_message_queue.test"
+	datasourceName := "data.yandex_message_queue.by_name"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceYandexMessageQueueFIFOConfig(randInt),
+				Check: resource.ComposeTestCheckFunc(
+					testAccDataSourceYandexMessageQueueCheck(datasourceName, resourceName),
+					resource.TestCheckResourceAttr(datasourceName, "fifo_queue", "true"),
+					resource.TestCheckResourceAttr(datasourceName, "content_based_deduplication", "true"),
+					resource.TestCheckResourceAttr(datasourceName, "visibility_timeout_seconds", "60"),
+					resource.TestCheckResourceAttr(datasourceName, "message_retention_seconds", "86400"),
+					resource.TestCheckResourceAttrSet(datasourceName, "url"),
+				),
+			},
+		},
+	})
+}
+
 func testAccDataSourceYandexMessageQueueCheck(datasourceName, resourceName string) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		rs, ok := s.RootModule().Resources[datasourceName]
@@ -41,6 +105,14 @@ func testAccDataSourceYandexMessageQueueCheck(datasourceName, resourceName strin
 		attrNames := []string{
 			"arn",
 			"name",
+			"fifo_queue",
+			"content_based_deduplication",
+			"delay_seconds",
+			"visibility_timeout_seconds",
+			"message_retention_seconds",
+			"max_message_size",
+			"receive_wait_time_seconds",
+			"redrive_policy",
 		}
 
 		for _, attrName := range attrNames {
@@ -82,3 +154,25 @@ data "yandex_message_queue" "by_name" {
 }
 `, randInt) + testAccCommonIamDependenciesEditorConfig(randInt)
 }
+
+func testAccDataSourceYandexMessageQueueFIFOConfig(randInt int) string {
+	return fmt.Sprintf(`
+resource "yandex_message_queue" "test" {
+  name                        = "%[1]d.fifo"
+  fifo_queue                  = true
+  content_based_deduplication = true
+  visibility_timeout_seconds  = 60
+  message_retention_seconds   = 86400
+
+  access_key = yandex_iam_service_account_static_access_key.sa-key.access_key
+  secret_key = yandex_iam_service_account_static_access_key.sa-key.secret_key
+}
+
+data "yandex_message_queue" "by_name" {
+  name = yandex_message_queue.test.name
+
+  access_key = yandex_iam_service_account_static_access_key.sa-key.access_key
+  secret_key = yandex_iam_service_account_static_access_key.sa-key.secret_key
+}
+`, randInt) + testAccCommonIamDependenciesEditorConfig(randInt)
+}
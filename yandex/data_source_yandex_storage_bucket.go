@@ -0,0 +1,52 @@
+package yandex
+
+import (
+	"context"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func dataSourceYandexStorageBucket() *schema.Resource {
+	dataSource := convertResourceToDataSource(resourceYandexStorageBucket())
+
+	dataSource.Description = "Get information about a Yandex Cloud Storage Bucket. For more information, see [the official documentation](https://yandex.cloud/docs/storage/concepts/bucket)."
+
+	delete(dataSource.Schema, "bucket_prefix")
+	delete(dataSource.Schema, "force_destroy")
+
+	dataSource.Schema["bucket"].Computed = false
+	dataSource.Schema["bucket"].Required = true
+	dataSource.Schema["bucket"].Description = "The name of the bucket."
+
+	dataSource.Schema["access_key"].Computed = false
+	dataSource.Schema["access_key"].Optional = true
+	dataSource.Schema["access_key"].Description = "The access key to use when reading bucket. This value can also be provided as `storage_access_key` specified in provider config (explicitly or within `shared_credentials_file`) is used."
+	dataSource.Schema["secret_key"].Computed = false
+	dataSource.Schema["secret_key"].Optional = true
+	dataSource.Schema["secret_key"].Description = "The secret key to use when reading bucket. This value can also be provided as `storage_secret_key` specified in provider config (explicitly or within `shared_credentials_file`) is used."
+
+	dataSource.ReadContext = dataSourceYandexStorageBucketRead
+	return dataSource
+}
+
+func dataSourceYandexStorageBucketRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	bucketName := d.Get("bucket").(string)
+	d.SetId(bucketName)
+
+	err := resourceYandexStorageBucketReadBasic(ctx, d, meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if d.Id() == "" {
+		return diag.Errorf("Storage Bucket %q not found", bucketName)
+	}
+
+	err = resourceYandexStorageBucketReadExtended(d, meta)
+	if err != nil {
+		log.Printf("[WARN] Got an error reading Storage Bucket's extended properties: %s", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,81 @@
+package yandex
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+const storageBucketDataSource = "data.yandex_storage_bucket.test"
+
+func TestAccDataSourceStorageBucket_basic(t *testing.T) {
+	rInt := acctest.RandInt()
+	resourceName := "yandex_storage_bucket.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProviderFactoriesV6,
+		CheckDestroy:             testAccCheckStorageBucketDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceStorageBucketConfig(rInt),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckStorageBucketExists(resourceName),
+					resource.TestCheckResourceAttr(storageBucketDataSource, "bucket", testAccBucketName(rInt)),
+					resource.TestCheckResourceAttr(storageBucketDataSource, "id", testAccBucketName(rInt)),
+					resource.TestCheckResourceAttr(
+						storageBucketDataSource,
+						"bucket_domain_name",
+						testAccBucketDomainName(rInt),
+					),
+					resource.TestCheckResourceAttrPair(storageBucketDataSource, "folder_id", resourceName, "folder_id"),
+					resource.TestCheckResourceAttr(storageBucketDataSource, "default_storage_class", "STANDARD"),
+					resource.TestCheckResourceAttr(storageBucketDataSource, "versioning.0.enabled", "true"),
+					resource.TestCheckResourceAttr(storageBucketDataSource, "website.0.index_document", "index.html"),
+					resource.TestCheckResourceAttr(storageBucketDataSource, "website_endpoint", testAccWebsiteEndpoint(rInt)),
+					resource.TestCheckResourceAttr(storageBucketDataSource, "cors_rule.#", "1"),
+					resource.TestCheckResourceAttr(storageBucketDataSource, "cors_rule.0.allowed_methods.0", "GET"),
+					resource.TestCheckResourceAttr(storageBucketDataSource, "lifecycle_rule.#", "1"),
+					resource.TestCheckResourceAttr(storageBucketDataSource, "lifecycle_rule.0.id", "expire-logs"),
+					resource.TestCheckResourceAttr(storageBucketDataSource, "tags.env", "test"),
+				),
+			},
+		},
+	})
+}
+
+func testAccDataSourceStorageBucketConfig(randInt int) string {
+	return newBucketConfigBuilder(randInt).
+		addStatement(`versioning {
+		enabled = true
+	}`).
+		addStatement(`website {
+		index_document = "index.html"
+	}`).
+		addStatement(`cors_rule {
+		allowed_methods = ["GET"]
+		allowed_origins = ["*"]
+	}`).
+		addStatement(`lifecycle_rule {
+		id      = "expire-logs"
+		enabled = true
+		filter {
+			prefix = "logs/"
+		}
+		expiration {
+			days = 30
+		}
+	}`).
+		addStatement(`tags = {
+		env = "test"
+	}`).
+		after(`data "yandex_storage_bucket" "test" {
+	bucket = yandex_storage_bucket.test.bucket
+
+	access_key = yandex_iam_service_account_static_access_key.sa-key.access_key
+	secret_key = yandex_iam_service_account_static_access_key.sa-key.secret_key
+}`).
+		asAdmin().
+		render()
+}
@@ -111,10 +111,10 @@ func (fieldsInfo *objectFieldsInfo) intCheckSetValue(field string, v *int) error
 
 	if fieldInfo, ok := fieldsInfo.fieldsManual[field]; ok {
 		if fieldInfo.minIntVal != nil && *fieldInfo.minIntVal > *v {
-			return fmt.Errorf("intCheckSetValue: min value for %s is %v value is %v", field, fieldInfo.minIntVal, v)
+			return fmt.Errorf("intCheckSetValue: min value for %s is %v value is %v", field, *fieldInfo.minIntVal, *v)
 		}
 		if fieldInfo.maxMaxVal != nil && *fieldInfo.maxMaxVal < *v {
-			return fmt.Errorf("intCheckSetValue: max value for %s is %v value is %v", field, fieldInfo.maxMaxVal, v)
+			return fmt.Errorf("intCheckSetValue: max value for %s is %v value is %v", field, *fieldInfo.maxMaxVal, *v)
 		}
 	}
 
@@ -369,6 +369,16 @@ func (fieldsInfo *objectFieldsInfo) addIDefault(field string, def int) *objectFi
 	return fieldsInfo
 }
 
+// addIMin sets the minimal allowed value of the int field
+func (fieldsInfo *objectFieldsInfo) addIMin(field string, min int) *objectFieldsInfo {
+
+	fieldInfo := fieldsInfo.fieldsManual[field]
+	fieldInfo.minIntVal = &min
+	fieldsInfo.fieldsManual[field] = fieldInfo
+
+	return fieldsInfo
+}
+
 // default value is 0
 func (fieldsInfo *objectFieldsInfo) addEnumGeneratedNames(field string, values map[int32]string) *objectFieldsInfo {
 
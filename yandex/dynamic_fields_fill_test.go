@@ -532,3 +532,21 @@ func TestFieldsDynamicGenerateMapSchemaDiffSuppressFuncEnum(t *testing.T) {
 		t.Errorf("generateMapSchemaDiffSuppressFunc: enum values should be equal when new value is empty")
 	}
 }
+
+func TestFieldsDynamicGenerateMapSchemaValidateFuncIntMin(t *testing.T) {
+	t.Parallel()
+
+	validateFunc := generateMapSchemaValidateFunc(mdbPGSettingsFieldsInfo17)
+
+	for _, value := range []string{"-1", "0", "1000"} {
+		_, errors := validateFunc(map[string]interface{}{"log_min_duration_statement": value}, "")
+		if len(errors) > 0 {
+			t.Errorf("generateMapSchemaValidateFunc: log_min_duration_statement = %s should be valid, but got: %v", value, errors)
+		}
+	}
+
+	_, errors := validateFunc(map[string]interface{}{"log_min_duration_statement": "-2"}, "")
+	if len(errors) != 1 {
+		t.Errorf("generateMapSchemaValidateFunc: log_min_duration_statement = -2 should be rejected, but got %d errors: %v", len(errors), errors)
+	}
+}
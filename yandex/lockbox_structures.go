@@ -3,6 +3,7 @@ package yandex
 import (
 	"bytes"
 	"context"
+	"encoding/base64"
 	"fmt"
 	"os/exec"
 	"regexp"
@@ -14,8 +15,9 @@ import (
 
 type lockboxEntryCheck struct {
 	Key string
-	// set Val or Regexp
+	// set Val, Binary or Regexp
 	Val    string
+	Binary []byte
 	Regexp *regexp.Regexp
 }
 
@@ -64,10 +66,22 @@ func expandLockboxSecretVersionEntries(ctx context.Context, d *schema.ResourceDa
 		val.SetTextValue(v.(string))
 	}
 
-	if execRaw, ok := d.GetOk(fmt.Sprintf("entries.%d.command.0", indexes...)); ok {
+	if v, ok := d.GetOk(fmt.Sprintf("entries.%d.binary_value", indexes...)); ok {
 		if val.GetTextValue() != "" {
 			// We must validate manually - https://github.com/hashicorp/terraform-plugin-sdk/issues/470
-			return nil, fmt.Errorf("key %v has both text_value and command, but only one of those must be set", val.GetKey())
+			return nil, fmt.Errorf("key %v has both text_value and binary_value, but only one of those must be set", val.GetKey())
+		}
+		binaryValue, err := base64.StdEncoding.DecodeString(v.(string))
+		if err != nil {
+			return nil, fmt.Errorf("key %v has binary_value that is not valid base64: %s", val.GetKey(), err)
+		}
+		val.SetBinaryValue(binaryValue)
+	}
+
+	if execRaw, ok := d.GetOk(fmt.Sprintf("entries.%d.command.0", indexes...)); ok {
+		if val.GetTextValue() != "" || len(val.GetBinaryValue()) > 0 {
+			// We must validate manually - https://github.com/hashicorp/terraform-plugin-sdk/issues/470
+			return nil, fmt.Errorf("key %v has command together with text_value or binary_value, but only one of those must be set", val.GetKey())
 		}
 		execMap := execRaw.(map[string]interface{})
 		result, err := resolveCommand(ctx, execMap)
@@ -77,7 +91,7 @@ func expandLockboxSecretVersionEntries(ctx context.Context, d *schema.ResourceDa
 		val.SetTextValue(result)
 	}
 
-	if val.GetTextValue() == "" {
+	if val.GetTextValue() == "" && len(val.GetBinaryValue()) == 0 {
 		return nil, fmt.Errorf("no value for key %v", val.GetKey())
 	}
 
@@ -121,10 +135,14 @@ func flattenLockboxSecretVersionEntriesSlice(vs []*lockbox.Payload_Entry) ([]int
 }
 
 func flattenLockboxSecretVersionEntry(v *lockbox.Payload_Entry) map[string]interface{} {
-	return map[string]interface{}{
+	m := map[string]interface{}{
 		"key":        v.Key,
 		"text_value": v.GetTextValue(),
 	}
+	if binaryValue := v.GetBinaryValue(); len(binaryValue) > 0 {
+		m["binary_value"] = base64.StdEncoding.EncodeToString(binaryValue)
+	}
+	return m
 }
 
 func flattenPasswordPayloadSpecification(passwordPayloadSpecification *lockbox.PasswordPayloadSpecification) []map[string]interface{} {
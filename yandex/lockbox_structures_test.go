@@ -0,0 +1,137 @@
+package yandex
+
+import (
+	"context"
+	"encoding/base64"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/stretchr/testify/require"
+	"github.com/yandex-cloud/go-genproto/yandex/cloud/lockbox/v1"
+)
+
+func TestExpandLockboxSecretVersionEntries(t *testing.T) {
+	binary := []byte{0x00, 0x01, 0xfe, 0xff}
+	tests := []struct {
+		name        string
+		entry       map[string]interface{}
+		expected    *lockbox.PayloadEntryChange
+		expectedErr string
+	}{
+		{
+			name: "text value",
+			entry: map[string]interface{}{
+				"key":        "k1",
+				"text_value": "v1",
+			},
+			expected: &lockbox.PayloadEntryChange{
+				Key:   "k1",
+				Value: &lockbox.PayloadEntryChange_TextValue{TextValue: "v1"},
+			},
+		},
+		{
+			name: "binary value",
+			entry: map[string]interface{}{
+				"key":          "k1",
+				"binary_value": base64.StdEncoding.EncodeToString(binary),
+			},
+			expected: &lockbox.PayloadEntryChange{
+				Key:   "k1",
+				Value: &lockbox.PayloadEntryChange_BinaryValue{BinaryValue: binary},
+			},
+		},
+		{
+			name: "text and binary values",
+			entry: map[string]interface{}{
+				"key":          "k1",
+				"text_value":   "v1",
+				"binary_value": base64.StdEncoding.EncodeToString(binary),
+			},
+			expectedErr: "key k1 has both text_value and binary_value, but only one of those must be set",
+		},
+		{
+			name: "no value",
+			entry: map[string]interface{}{
+				"key": "k1",
+			},
+			expectedErr: "no value for key k1",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			raw := map[string]interface{}{
+				"entries": []interface{}{test.entry},
+			}
+			resourceData := schema.TestResourceDataRaw(t, resourceYandexLockboxSecretVersion().Schema, raw)
+			actual, err := expandLockboxSecretVersionEntries(context.Background(), resourceData, 0)
+			if test.expectedErr != "" {
+				require.EqualError(t, err, test.expectedErr)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, test.expected, actual)
+		})
+	}
+}
+
+func TestFlattenLockboxSecretVersionEntry(t *testing.T) {
+	binary := []byte{0x00, 0x01, 0xfe, 0xff}
+	tests := []struct {
+		name     string
+		entry    *lockbox.Payload_Entry
+		expected map[string]interface{}
+	}{
+		{
+			name: "text value",
+			entry: &lockbox.Payload_Entry{
+				Key:   "k1",
+				Value: &lockbox.Payload_Entry_TextValue{TextValue: "v1"},
+			},
+			expected: map[string]interface{}{
+				"key":        "k1",
+				"text_value": "v1",
+			},
+		},
+		{
+			name: "binary value",
+			entry: &lockbox.Payload_Entry{
+				Key:   "k1",
+				Value: &lockbox.Payload_Entry_BinaryValue{BinaryValue: binary},
+			},
+			expected: map[string]interface{}{
+				"key":          "k1",
+				"text_value":   "",
+				"binary_value": base64.StdEncoding.EncodeToString(binary),
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			require.Equal(t, test.expected, flattenLockboxSecretVersionEntry(test.entry))
+		})
+	}
+}
+
+func TestLockboxSecretVersionBinaryValueRoundtrip(t *testing.T) {
+	binary := []byte("\x00binary\npayload\xff")
+	raw := map[string]interface{}{
+		"entries": []interface{}{
+			map[string]interface{}{
+				"key":          "k1",
+				"binary_value": base64.StdEncoding.EncodeToString(binary),
+			},
+		},
+	}
+	resourceData := schema.TestResourceDataRaw(t, resourceYandexLockboxSecretVersion().Schema, raw)
+	change, err := expandLockboxSecretVersionEntries(context.Background(), resourceData, 0)
+	require.NoError(t, err)
+	require.Equal(t, binary, change.GetBinaryValue())
+
+	flattened := flattenLockboxSecretVersionEntry(&lockbox.Payload_Entry{
+		Key:   change.GetKey(),
+		Value: &lockbox.Payload_Entry_BinaryValue{BinaryValue: change.GetBinaryValue()},
+	})
+	require.Equal(t, raw["entries"].([]interface{})[0].(map[string]interface{})["binary_value"], flattened["binary_value"])
+}
@@ -1688,6 +1688,7 @@ func getMdbPGSettingsFieldsInfo(version string) (*objectFieldsInfo, error) {
 
 var mdbPGSettingsFieldsInfo17 = newObjectFieldsInfo().
 	addType(config.PostgresqlConfig17{}).
+	addIMin("log_min_duration_statement", -1).
 	addEnumGeneratedNamesWithCompareAndValidFuncs("wal_level", config.PostgresqlConfig17_WalLevel_name).
 	addEnumGeneratedNamesWithCompareAndValidFuncs("synchronous_commit", config.PostgresqlConfig17_SynchronousCommit_name).
 	addEnumGeneratedNamesWithCompareAndValidFuncs("constraint_exclusion", config.PostgresqlConfig17_ConstraintExclusion_name).
@@ -1715,6 +1716,7 @@ var mdbPGSettingsFieldsInfo17 = newObjectFieldsInfo().
 
 var mdbPGSettingsFieldsInfo17_1C = newObjectFieldsInfo().
 	addType(config.PostgresqlConfig17_1C{}).
+	addIMin("log_min_duration_statement", -1).
 	addEnumGeneratedNamesWithCompareAndValidFuncs("wal_level", config.PostgresqlConfig17_1C_WalLevel_name).
 	addEnumGeneratedNamesWithCompareAndValidFuncs("synchronous_commit", config.PostgresqlConfig17_1C_SynchronousCommit_name).
 	addEnumGeneratedNamesWithCompareAndValidFuncs("constraint_exclusion", config.PostgresqlConfig17_1C_ConstraintExclusion_name).
@@ -1742,6 +1744,7 @@ var mdbPGSettingsFieldsInfo17_1C = newObjectFieldsInfo().
 
 var mdbPGSettingsFieldsInfo16 = newObjectFieldsInfo().
 	addType(config.PostgresqlConfig16{}).
+	addIMin("log_min_duration_statement", -1).
 	addEnumGeneratedNamesWithCompareAndValidFuncs("wal_level", config.PostgresqlConfig16_WalLevel_name).
 	addEnumGeneratedNamesWithCompareAndValidFuncs("synchronous_commit", config.PostgresqlConfig16_SynchronousCommit_name).
 	addEnumGeneratedNamesWithCompareAndValidFuncs("constraint_exclusion", config.PostgresqlConfig16_ConstraintExclusion_name).
@@ -1765,6 +1768,7 @@ var mdbPGSettingsFieldsInfo16 = newObjectFieldsInfo().
 
 var mdbPGSettingsFieldsInfo16_1C = newObjectFieldsInfo().
 	addType(config.PostgresqlConfig16_1C{}).
+	addIMin("log_min_duration_statement", -1).
 	addEnumGeneratedNamesWithCompareAndValidFuncs("wal_level", config.PostgresqlConfig16_1C_WalLevel_name).
 	addEnumGeneratedNamesWithCompareAndValidFuncs("synchronous_commit", config.PostgresqlConfig16_1C_SynchronousCommit_name).
 	addEnumGeneratedNamesWithCompareAndValidFuncs("constraint_exclusion", config.PostgresqlConfig16_1C_ConstraintExclusion_name).
@@ -1788,6 +1792,7 @@ var mdbPGSettingsFieldsInfo16_1C = newObjectFieldsInfo().
 
 var mdbPGSettingsFieldsInfo15 = newObjectFieldsInfo().
 	addType(config.PostgresqlConfig15{}).
+	addIMin("log_min_duration_statement", -1).
 	addEnumGeneratedNamesWithCompareAndValidFuncs("wal_level", config.PostgresqlConfig15_WalLevel_name).
 	addEnumGeneratedNamesWithCompareAndValidFuncs("synchronous_commit", config.PostgresqlConfig15_SynchronousCommit_name).
 	addEnumGeneratedNamesWithCompareAndValidFuncs("constraint_exclusion", config.PostgresqlConfig15_ConstraintExclusion_name).
@@ -1811,6 +1816,7 @@ var mdbPGSettingsFieldsInfo15 = newObjectFieldsInfo().
 
 var mdbPGSettingsFieldsInfo15_1C = newObjectFieldsInfo().
 	addType(config.PostgresqlConfig15_1C{}).
+	addIMin("log_min_duration_statement", -1).
 	addEnumGeneratedNamesWithCompareAndValidFuncs("wal_level", config.PostgresqlConfig15_1C_WalLevel_name).
 	addEnumGeneratedNamesWithCompareAndValidFuncs("synchronous_commit", config.PostgresqlConfig15_1C_SynchronousCommit_name).
 	addEnumGeneratedNamesWithCompareAndValidFuncs("constraint_exclusion", config.PostgresqlConfig15_1C_ConstraintExclusion_name).
@@ -1834,6 +1840,7 @@ var mdbPGSettingsFieldsInfo15_1C = newObjectFieldsInfo().
 
 var mdbPGSettingsFieldsInfo14 = newObjectFieldsInfo().
 	addType(config.PostgresqlConfig14{}).
+	addIMin("log_min_duration_statement", -1).
 	addEnumGeneratedNamesWithCompareAndValidFuncs("wal_level", config.PostgresqlConfig14_WalLevel_name).
 	addEnumGeneratedNamesWithCompareAndValidFuncs("synchronous_commit", config.PostgresqlConfig14_SynchronousCommit_name).
 	addEnumGeneratedNamesWithCompareAndValidFuncs("constraint_exclusion", config.PostgresqlConfig14_ConstraintExclusion_name).
@@ -1857,6 +1864,7 @@ var mdbPGSettingsFieldsInfo14 = newObjectFieldsInfo().
 
 var mdbPGSettingsFieldsInfo14_1C = newObjectFieldsInfo().
 	addType(config.PostgresqlConfig14_1C{}).
+	addIMin("log_min_duration_statement", -1).
 	addEnumGeneratedNamesWithCompareAndValidFuncs("wal_level", config.PostgresqlConfig14_1C_WalLevel_name).
 	addEnumGeneratedNamesWithCompareAndValidFuncs("synchronous_commit", config.PostgresqlConfig14_1C_SynchronousCommit_name).
 	addEnumGeneratedNamesWithCompareAndValidFuncs("constraint_exclusion", config.PostgresqlConfig14_1C_ConstraintExclusion_name).
@@ -1880,6 +1888,7 @@ var mdbPGSettingsFieldsInfo14_1C = newObjectFieldsInfo().
 
 var mdbPGSettingsFieldsInfo13 = newObjectFieldsInfo().
 	addType(config.PostgresqlConfig13{}).
+	addIMin("log_min_duration_statement", -1).
 	addEnumGeneratedNamesWithCompareAndValidFuncs("wal_level", config.PostgresqlConfig13_WalLevel_name).
 	addEnumGeneratedNamesWithCompareAndValidFuncs("synchronous_commit", config.PostgresqlConfig13_SynchronousCommit_name).
 	addEnumGeneratedNamesWithCompareAndValidFuncs("constraint_exclusion", config.PostgresqlConfig13_ConstraintExclusion_name).
@@ -1902,6 +1911,7 @@ var mdbPGSettingsFieldsInfo13 = newObjectFieldsInfo().
 
 var mdbPGSettingsFieldsInfo13_1C = newObjectFieldsInfo().
 	addType(config.PostgresqlConfig13_1C{}).
+	addIMin("log_min_duration_statement", -1).
 	addEnumGeneratedNamesWithCompareAndValidFuncs("wal_level", config.PostgresqlConfig13_1C_WalLevel_name).
 	addEnumGeneratedNamesWithCompareAndValidFuncs("synchronous_commit", config.PostgresqlConfig13_1C_SynchronousCommit_name).
 	addEnumGeneratedNamesWithCompareAndValidFuncs("constraint_exclusion", config.PostgresqlConfig13_1C_ConstraintExclusion_name).
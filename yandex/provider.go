@@ -168,6 +168,9 @@ func sdkProvider(emptyFolder bool) *schema.Provider {
 			"yandex_compute_snapshot":                                 dataSourceYandexComputeSnapshot(),
 			"yandex_compute_snapshot_schedule":                        dataSourceYandexComputeSnapshotSchedule(),
 			"yandex_dataproc_cluster":                                 dataSourceYandexDataprocCluster(),
+			"yandex_datatransfer_endpoint":                            dataSourceYandexDatatransferEndpoint(),
+			"yandex_datatransfer_transfer":                            dataSourceYandexDatatransferTransfer(),
+			"yandex_dns_recordset":                                    dataSourceYandexDnsRecordSet(),
 			"yandex_dns_zone":                                         dataSourceYandexDnsZone(),
 			"yandex_serverless_eventrouter_bus":                       dataSourceYandexServerlessEventrouterBus(),
 			"yandex_serverless_eventrouter_connector":                 dataSourceYandexServerlessEventrouterConnector(),
@@ -178,6 +181,7 @@ func sdkProvider(emptyFolder bool) *schema.Provider {
 			"yandex_iam_policy":                                       dataSourceYandexIAMPolicy(),
 			"yandex_iam_role":                                         dataSourceYandexIAMRole(),
 			"yandex_iam_service_account":                              dataSourceYandexIAMServiceAccount(),
+			"yandex_iam_service_account_iam_policy":                   dataSourceYandexIAMServiceAccountIAMPolicy(),
 			"yandex_iam_service_agent":                                dataSourceYandexIamServiceAgent(),
 			"yandex_iam_user":                                         dataSourceYandexIAMUser(),
 			"yandex_iam_workload_identity_federated_credential":       dataSourceYandexIAMWorkloadIdentityFederatedCredential(),
@@ -221,6 +225,7 @@ func sdkProvider(emptyFolder bool) *schema.Provider {
 			"yandex_resourcemanager_cloud":                            dataSourceYandexResourceManagerCloud(),
 			"yandex_resourcemanager_folder":                           dataSourceYandexResourceManagerFolder(),
 			"yandex_serverless_container":                             dataSourceYandexServerlessContainer(),
+			"yandex_storage_bucket":                                   dataSourceYandexStorageBucket(),
 			"yandex_vpc_address":                                      dataSourceYandexVPCAddress(),
 			"yandex_vpc_gateway":                                      dataSourceYandexVPCGateway(),
 			"yandex_vpc_network":                                      dataSourceYandexVPCNetwork(),
@@ -251,6 +256,7 @@ func sdkProvider(emptyFolder bool) *schema.Provider {
 			"yandex_container_registry_ip_permission":                 resourceYandexContainerRegistryIPPermission(),
 			"yandex_container_repository":                             resourceYandexContainerRepository(),
 			"yandex_container_repository_lifecycle_policy":            resourceYandexContainerRepositoryLifecyclePolicy(),
+			"yandex_cdn_cache_invalidation":                           resourceYandexCDNCacheInvalidation(),
 			"yandex_cdn_origin_group":                                 resourceYandexCDNOriginGroup(),
 			"yandex_cdn_resource":                                     resourceYandexCDNResource(),
 			"yandex_cm_certificate":                                   resourceYandexCMCertificate(),
@@ -275,6 +281,7 @@ func sdkProvider(emptyFolder bool) *schema.Provider {
 			"yandex_function":                                         resourceYandexFunction(),
 			"yandex_function_scaling_policy":                          resourceYandexFunctionScalingPolicy(),
 			"yandex_function_trigger":                                 resourceYandexFunctionTrigger(),
+			"yandex_function_version":                                 resourceYandexFunctionVersion(),
 			"yandex_iam_service_account":                              resourceYandexIAMServiceAccount(),
 			"yandex_iam_service_account_api_key":                      resourceYandexIAMServiceAccountAPIKey(),
 			"yandex_iam_service_account_iam_policy":                   resourceYandexIAMServiceAccountIAMPolicy(),
@@ -614,7 +614,7 @@ func flattenApiGatewayVariables(variables map[string]*apigateway.VariableInput)
 }
 
 func flattenApiGatewayCanary(canary *apigateway.Canary) []interface{} {
-	if canary == nil || len(canary.Variables) == 0 {
+	if canary == nil || (canary.Weight == 0 && len(canary.Variables) == 0) {
 		return nil
 	}
 	return []interface{}{
@@ -626,16 +626,18 @@ func flattenApiGatewayCanary(canary *apigateway.Canary) []interface{} {
 }
 
 func expandApiGatewayCanary(d *schema.ResourceData) *apigateway.Canary {
-	w, okW := d.GetOk("canary.0.weight")
+	weight, okW := d.GetOk("canary.0.weight")
 	variables, okV := d.GetOk("canary.0.variables")
-	weight, okI := w.(int)
-	if !okW || !okV || !okI {
+	if !okW && !okV {
 		return nil
 	}
-	return &apigateway.Canary{
-		Weight:    int64(weight),
-		Variables: expandVariables(variables),
+	canary := &apigateway.Canary{
+		Weight: int64(weight.(int)),
 	}
+	if okV {
+		canary.Variables = expandVariables(variables)
+	}
+	return canary
 }
 
 func expandApiGatewayLogOptions(d *schema.ResourceData) (*apigateway.LogOptions, error) {
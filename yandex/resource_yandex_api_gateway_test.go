@@ -402,6 +402,39 @@ resource "yandex_api_gateway" "test-api-gateway" {
 	})
 }
 
+func TestAccYandexAPIGateway_canaryWithoutVariables(t *testing.T) {
+	t.Parallel()
+
+	var apiGateway apigateway.ApiGateway
+	config := fmt.Sprintf(`
+resource "yandex_api_gateway" "test-api-gateway" {
+  name = "%s"
+  canary {
+    weight = 10
+  }
+  spec = <<EOF
+%sEOF
+}`, acctest.RandomWithPrefix("tf-api-gateway"), specParametrized)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testYandexAPIGatewayDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					testYandexAPIGatewayExists(apiGatewayResource, &apiGateway),
+					testYandexAPIGatewayContainsCanaryWithStringVariable(&apiGateway, 10, nil),
+					resource.TestCheckResourceAttr(apiGatewayResource, "canary.#", "1"),
+					resource.TestCheckResourceAttr(apiGatewayResource, "canary.0.weight", "10"),
+					resource.TestCheckResourceAttr(apiGatewayResource, "canary.0.variables.%", "0"),
+				),
+			},
+		},
+	})
+}
+
 func TestAccYandexAPIGateway_executionTimeout(t *testing.T) {
 	t.Parallel()
 
@@ -0,0 +1,105 @@
+package yandex
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/yandex-cloud/go-genproto/yandex/cloud/cdn/v1"
+)
+
+const (
+	yandexCDNCacheInvalidationDefaultTimeout = 5 * time.Minute
+)
+
+func resourceYandexCDNCacheInvalidation() *schema.Resource {
+	return &schema.Resource{
+		Description: "Purges the cache of a [Yandex Cloud CDN Resource](https://yandex.cloud/docs/cdn/concepts/caching#purge).\n\n~> Cache invalidation is a one-time operation. The cache is purged when this resource is created and every time `resource_id` or `paths` change. Destroying this resource has no effect on the CDN Resource cache.\n",
+		Create:      resourceYandexCDNCacheInvalidationCreate,
+		Read:        resourceYandexCDNCacheInvalidationRead,
+		Delete:      resourceYandexCDNCacheInvalidationDelete,
+
+		SchemaVersion: 0,
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(yandexCDNCacheInvalidationDefaultTimeout),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"resource_id": {
+				Type:        schema.TypeString,
+				Description: "ID of the CDN Resource to purge the cache of.",
+				Required:    true,
+				ForceNew:    true,
+			},
+			"paths": {
+				Type:        schema.TypeList,
+				Description: "Paths of the files to remove from the cache. Asterisk (`*`) may be used as a wildcard substituting any number of characters. If not specified, the cache is purged entirely.",
+				Optional:    true,
+				ForceNew:    true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+		},
+	}
+}
+
+func resourceYandexCDNCacheInvalidationCreate(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+
+	ctx, cancel := context.WithTimeout(config.Context(), d.Timeout(schema.TimeoutCreate))
+	defer cancel()
+
+	request := &cdn.PurgeCacheRequest{
+		ResourceId: d.Get("resource_id").(string),
+		Paths:      expandStringSlice(d.Get("paths").([]interface{})),
+	}
+
+	log.Printf("[DEBUG] Purging cache of CDN Resource %q: %+v", request.ResourceId, request)
+
+	operation, err := config.sdk.WrapOperation(config.sdk.CDN().Cache().Purge(ctx, request))
+	if err != nil {
+		return fmt.Errorf("error while requesting API to purge cache of CDN Resource %q: %s", request.ResourceId, err)
+	}
+
+	d.SetId(operation.Id())
+
+	if err = operation.Wait(ctx); err != nil {
+		return fmt.Errorf("error while waiting operation to purge cache of CDN Resource %q: %s", request.ResourceId, err)
+	}
+
+	if _, err := operation.Response(); err != nil {
+		return fmt.Errorf("cache purge of CDN Resource %q failed: %s", request.ResourceId, err)
+	}
+
+	log.Printf("[DEBUG] Completed purging cache of CDN Resource %q", request.ResourceId)
+
+	return resourceYandexCDNCacheInvalidationRead(d, meta)
+}
+
+func resourceYandexCDNCacheInvalidationRead(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	resourceID := d.Get("resource_id").(string)
+
+	// The purge itself leaves nothing to read back, so only make sure
+	// the CDN Resource it was issued for still exists.
+	_, err := config.sdk.CDN().Resource().Get(config.Context(), &cdn.GetResourceRequest{
+		ResourceId: resourceID,
+	})
+	if err != nil {
+		return handleNotFoundError(err, d, fmt.Sprintf("CDN Resource %q", resourceID))
+	}
+
+	return nil
+}
+
+func resourceYandexCDNCacheInvalidationDelete(d *schema.ResourceData, meta interface{}) error {
+	log.Printf("[DEBUG] Removing CDN cache invalidation %q from state, CDN Resource cache is left as is", d.Id())
+
+	d.SetId("")
+	return nil
+}
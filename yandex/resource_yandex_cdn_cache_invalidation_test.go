@@ -0,0 +1,94 @@
+package yandex
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+)
+
+func TestAccCDNCacheInvalidation_basic(t *testing.T) {
+	t.Parallel()
+
+	groupName := fmt.Sprintf("tf-test-cdn-resource-%s", acctest.RandString(10))
+	resourceCName := fmt.Sprintf("cdn-tf-test-%s.yandex.net", acctest.RandString(4))
+
+	var invalidationID string
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckCDNResourceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCDNCacheInvalidation_basic(groupName, resourceCName, `"/index.html"`),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(
+						"yandex_cdn_cache_invalidation.foobar_invalidation", "resource_id",
+						"yandex_cdn_resource.foobar_resource", "id",
+					),
+					resource.TestCheckResourceAttr("yandex_cdn_cache_invalidation.foobar_invalidation", "paths.#", "1"),
+					resource.TestCheckResourceAttr("yandex_cdn_cache_invalidation.foobar_invalidation", "paths.0", "/index.html"),
+					testAccCDNCacheInvalidationID("yandex_cdn_cache_invalidation.foobar_invalidation", &invalidationID),
+				),
+			},
+			{
+				// changing paths must issue a new invalidation
+				Config: testAccCDNCacheInvalidation_basic(groupName, resourceCName, `"/index.html", "/static/*"`),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("yandex_cdn_cache_invalidation.foobar_invalidation", "paths.#", "2"),
+					resource.TestCheckResourceAttr("yandex_cdn_cache_invalidation.foobar_invalidation", "paths.1", "/static/*"),
+					testAccCDNCacheInvalidationIDChanged("yandex_cdn_cache_invalidation.foobar_invalidation", &invalidationID),
+				),
+			},
+		},
+	})
+}
+
+func testAccCDNCacheInvalidationID(name string, id *string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[name]
+		if !ok {
+			return fmt.Errorf("Not found: %s", name)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No ID is set")
+		}
+
+		*id = rs.Primary.ID
+		return nil
+	}
+}
+
+func testAccCDNCacheInvalidationIDChanged(name string, id *string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		previousID := *id
+		if err := testAccCDNCacheInvalidationID(name, id)(s); err != nil {
+			return err
+		}
+
+		if *id == previousID {
+			return fmt.Errorf("CDN cache invalidation %s was not re-issued", name)
+		}
+		return nil
+	}
+}
+
+func testAccCDNCacheInvalidation_basic(groupName, resourceCNAME, paths string) string {
+	return makeGroupResource(groupName) + fmt.Sprintf(`
+resource "yandex_cdn_resource" "foobar_resource" {
+	cname = "%s"
+
+	origin_group_id = "${yandex_cdn_origin_group.foo_cdn_group.id}"
+}
+
+resource "yandex_cdn_cache_invalidation" "foobar_invalidation" {
+	resource_id = "${yandex_cdn_resource.foobar_resource.id}"
+
+	paths = [%s]
+}
+`, resourceCNAME, paths)
+}
@@ -352,12 +352,13 @@ func defineYandexCDNResourceBaseSchema() *schema.Resource {
 									},
 									"excepted_values": {
 										Type:        schema.TypeList,
-										Description: "The list of specified IP addresses to be allowed or denied depending on acl policy type.",
+										Description: "The list of specified IP addresses in CIDR notation to be allowed or denied depending on acl policy type.",
 										Optional:    true,
 										Computed:    true,
 
 										Elem: &schema.Schema{
-											Type: schema.TypeString,
+											Type:         schema.TypeString,
+											ValidateFunc: validateCidrBlocks,
 										},
 									},
 								},
@@ -493,7 +494,9 @@ func expandCDNResourceOptions(d *schema.ResourceData) *cdn.ResourceOptions {
 		}
 	}
 
-	if rawOption, ok := d.GetOk("options.0.ignore_query_params"); ok {
+	queryParamsVariant := getCDNResourceQueryParamsVariantFromConfig(d)
+
+	if rawOption, ok := d.GetOk("options.0.ignore_query_params"); ok && isCDNResourceQueryParamsVariantAllowed(queryParamsVariant, "ignore_query_params") {
 		optionsSet = true
 
 		result.QueryParamsOptions = &cdn.ResourceOptions_QueryParamsOptions{
@@ -506,7 +509,7 @@ func expandCDNResourceOptions(d *schema.ResourceData) *cdn.ResourceOptions {
 		}
 	}
 
-	if rawOption, ok := d.GetOk("options.0.query_params_whitelist"); ok {
+	if rawOption, ok := d.GetOk("options.0.query_params_whitelist"); ok && isCDNResourceQueryParamsVariantAllowed(queryParamsVariant, "query_params_whitelist") {
 		optionsSet = true
 
 		var values []string
@@ -526,7 +529,7 @@ func expandCDNResourceOptions(d *schema.ResourceData) *cdn.ResourceOptions {
 		}
 	}
 
-	if rawOption, ok := d.GetOk("options.0.query_params_blacklist"); ok {
+	if rawOption, ok := d.GetOk("options.0.query_params_blacklist"); ok && isCDNResourceQueryParamsVariantAllowed(queryParamsVariant, "query_params_blacklist") {
 		optionsSet = true
 
 		var values []string
@@ -785,6 +788,41 @@ func expandCDNResourceOptions(d *schema.ResourceData) *cdn.ResourceOptions {
 	return result
 }
 
+// getCDNResourceQueryParamsVariantFromConfig returns the name of the query params option set in config.
+// These options are computed, so the state may still hold the previously used one after switching between them.
+func getCDNResourceQueryParamsVariantFromConfig(d *schema.ResourceData) string {
+	rawConfig := d.GetRawConfig()
+	if rawConfig.IsNull() || !rawConfig.IsKnown() {
+		return ""
+	}
+
+	options := rawConfig.GetAttr("options")
+	if options.IsNull() || !options.IsKnown() {
+		return ""
+	}
+
+	optionsList := options.AsValueSlice()
+	if len(optionsList) < 1 {
+		return ""
+	}
+
+	rawOptions := optionsList[0].AsValueMap()
+	if value, ok := rawOptions["ignore_query_params"]; ok && !value.IsNull() && value.IsKnown() && value.True() {
+		return "ignore_query_params"
+	}
+	for _, key := range []string{"query_params_whitelist", "query_params_blacklist"} {
+		if value, ok := rawOptions[key]; ok && !value.IsNull() && value.IsKnown() && value.LengthInt() > 0 {
+			return key
+		}
+	}
+
+	return ""
+}
+
+func isCDNResourceQueryParamsVariantAllowed(configVariant, variant string) bool {
+	return configVariant == "" || configVariant == variant
+}
+
 func prepareCDNResourceOptions(d *schema.ResourceData) *cdn.ResourceOptions {
 	if options := expandCDNResourceOptions(d); options != nil {
 		return options
@@ -484,6 +484,38 @@ func TestAccCDNResource_optionForwardHostHeader(t *testing.T) {
 	})
 }
 
+func TestAccCDNResource_optionQueryParamsSwitch(t *testing.T) {
+	groupName := fmt.Sprintf("tf-test-cdn-resource-%s", acctest.RandString(10))
+	resourceCName := fmt.Sprintf("cdn-tf-test-%s.yandex.net", acctest.RandString(4))
+
+	var cdnResource, cdnResourceUpdated cdn.Resource
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckCDNResourceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCDNResource_optionQueryParamsWhitelist(groupName, resourceCName),
+				Check: resource.ComposeTestCheckFunc(
+					testCDNResourceExists("yandex_cdn_resource.foobar_resource", &cdnResource),
+					resource.TestCheckResourceAttr("yandex_cdn_resource.foobar_resource", "options.0.query_params_whitelist.#", "2"),
+					resource.TestCheckResourceAttr("yandex_cdn_resource.foobar_resource", "options.0.query_params_whitelist.0", "page"),
+				),
+			},
+			{
+				Config: testAccCDNResource_optionIgnoreQueryParams(groupName, resourceCName),
+				Check: resource.ComposeTestCheckFunc(
+					testCDNResourceExists("yandex_cdn_resource.foobar_resource", &cdnResourceUpdated),
+					resource.TestCheckResourceAttrPtr("yandex_cdn_resource.foobar_resource", "id", &cdnResource.Id),
+					resource.TestCheckResourceAttr("yandex_cdn_resource.foobar_resource", "options.0.ignore_query_params", "true"),
+					resource.TestCheckResourceAttr("yandex_cdn_resource.foobar_resource", "options.0.query_params_whitelist.#", "0"),
+				),
+			},
+		},
+	})
+}
+
 func TestAccCDNResource_optionStaticHeaders(t *testing.T) {
 	folderID := getExampleFolderID()
 
@@ -585,7 +617,7 @@ func TestAccCDNResource_optionIPAddressACL(t *testing.T) {
 		CheckDestroy: testAccCheckCDNResourceDestroy,
 		Steps: []resource.TestStep{
 			{
-				Config: testAccCDNResource_optionIPAddressACL(groupName, resourceCName),
+				Config: testAccCDNResource_optionIPAddressACL(groupName, resourceCName, "192.168.3.2/32"),
 				Check: resource.ComposeTestCheckFunc(
 					testCDNResourceExists("yandex_cdn_resource.foobar_resource", &cdnResource),
 					resource.TestCheckResourceAttr("yandex_cdn_resource.foobar_resource", "cname", resourceCName),
@@ -602,6 +634,23 @@ func TestAccCDNResource_optionIPAddressACL(t *testing.T) {
 	})
 }
 
+func TestAccCDNResource_optionIPAddressACLInvalidCIDR(t *testing.T) {
+	groupName := fmt.Sprintf("tf-test-cdn-resource-%s", acctest.RandString(10))
+	resourceCName := fmt.Sprintf("cdn-tf-test-%s.yandex.net", acctest.RandString(4))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccCDNResource_optionIPAddressACL(groupName, resourceCName, "192.168.3.2"),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile("is not a valid IP CIDR range"),
+			},
+		},
+	})
+}
+
 func testSweepCDNResource(_ string) error {
 	conf, err := configForSweepers()
 	if err != nil {
@@ -718,6 +767,20 @@ resource "yandex_cdn_resource" "foobar_resource" {
 `, resourceCNAME)
 }
 
+func testAccCDNResource_optionQueryParamsWhitelist(groupName, resourceCNAME string) string {
+	return makeGroupResource(groupName) + fmt.Sprintf(`
+resource "yandex_cdn_resource" "foobar_resource" {
+	cname = "%s"
+
+	origin_group_id = "${yandex_cdn_origin_group.foo_cdn_group.id}"
+
+	options {
+		query_params_whitelist = ["page", "lang"]
+	}
+}
+`, resourceCNAME)
+}
+
 func testAccCDNResource_optionStaticHeaders(groupName, resourceCNAME string) string {
 	return makeGroupResource(groupName) + fmt.Sprintf(`
 resource "yandex_cdn_resource" "foobar_resource" {
@@ -781,7 +844,7 @@ resource "yandex_cdn_resource" "foobar_resource" {
 `, resourceCNAME)
 }
 
-func testAccCDNResource_optionIPAddressACL(groupName, resourceCNAME string) string {
+func testAccCDNResource_optionIPAddressACL(groupName, resourceCNAME, exceptedValue string) string {
 	return makeGroupResource(groupName) + fmt.Sprintf(`
 resource "yandex_cdn_resource" "foobar_resource" {
 	cname = "%s"
@@ -791,11 +854,11 @@ resource "yandex_cdn_resource" "foobar_resource" {
 	options {
 		ip_address_acl {
 			policy_type = "allow"
-			excepted_values = ["192.168.3.2/32"]
+			excepted_values = ["%s"]
 		}
 	}
 }
-`, resourceCNAME)
+`, resourceCNAME, exceptedValue)
 }
 
 func testAccCDNResource_optionCustomHostHeader(groupName, resourceCNAME, customHostHeader string) string {
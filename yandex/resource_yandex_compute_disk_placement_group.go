@@ -82,6 +82,23 @@ func resourceYandexComputeDiskPlacementGroup() *schema.Resource {
 				Description: common.ResourceDescriptions["created_at"],
 				Computed:    true,
 			},
+
+			"placement_strategy_spread": {
+				Type:          schema.TypeBool,
+				Description:   "A placement strategy with spread policy of the Disk Placement Group. Should be true or unset (conflicts with placement_strategy_partitions).",
+				Optional:      true,
+				Computed:      true,
+				ForceNew:      true,
+				ConflictsWith: []string{"placement_strategy_partitions"},
+			},
+
+			"placement_strategy_partitions": {
+				Type:          schema.TypeInt,
+				Description:   "A number of partitions in the placement strategy with partitions policy of the Disk Placement Group (conflicts with placement_strategy_spread).",
+				Optional:      true,
+				ForceNew:      true,
+				ConflictsWith: []string{"placement_strategy_spread"},
+			},
 		},
 	}
 }
@@ -110,6 +127,18 @@ func resourceYandexComputeDiskPlacementGroupCreate(d *schema.ResourceData, meta
 		ZoneId: d.Get("zone").(string),
 	}
 
+	// GetOk does not tell an explicit false from an unset value.
+	if spreadStrategy := d.GetRawConfig().GetAttr("placement_strategy_spread"); spreadStrategy.IsKnown() && !spreadStrategy.IsNull() && spreadStrategy.False() {
+		return fmt.Errorf("Invalid value for `placement_strategy_spread` should be true or unset")
+	}
+	if partitions, ok := d.GetOk("placement_strategy_partitions"); ok {
+		req.PlacementStrategy = &compute.CreateDiskPlacementGroupRequest_PartitionPlacementStrategy{
+			PartitionPlacementStrategy: &compute.DiskPartitionPlacementStrategy{
+				Partitions: int64(partitions.(int)),
+			},
+		}
+	}
+
 	ctx, cancel := context.WithTimeout(config.Context(), d.Timeout(schema.TimeoutCreate))
 	defer cancel()
 
@@ -160,6 +189,8 @@ func resourceYandexComputeDiskPlacementGroupRead(d *schema.ResourceData, meta in
 	d.Set("description", placementGroup.Description)
 	d.Set("zone", placementGroup.ZoneId)
 	d.Set("status", placementGroup.Status.String())
+	d.Set("placement_strategy_spread", placementGroup.GetSpreadPlacementStrategy() != nil)
+	d.Set("placement_strategy_partitions", placementGroup.GetPartitionPlacementStrategy().GetPartitions())
 
 	return d.Set("labels", placementGroup.Labels)
 }
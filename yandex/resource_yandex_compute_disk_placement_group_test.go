@@ -1,7 +1,9 @@
 package yandex
 
 import (
+	"context"
 	"fmt"
+	"regexp"
 	"testing"
 
 	"github.com/hashicorp/go-multierror"
@@ -124,6 +126,73 @@ func TestAccComputeDisk_createEmptyDiskPlacementGroupAndAssignLater(t *testing.T
 	})
 }
 
+func TestAccComputeDiskPlacementGroup_partitionStrategy(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckComputeDiskPlacementGroupDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDiskPlacementGroupPartitions(3),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("yandex_compute_disk_placement_group.pg", "placement_strategy_partitions", "3"),
+					resource.TestCheckResourceAttr("yandex_compute_disk_placement_group.pg", "placement_strategy_spread", "false"),
+				),
+			},
+			{
+				ResourceName:      "yandex_compute_disk_placement_group.pg",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccComputeDiskPlacementGroup_spreadStrategy(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckComputeDiskPlacementGroupDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccDiskPlacementGroupSpread(false),
+				ExpectError: regexp.MustCompile("Invalid value for `placement_strategy_spread` should be true or unset"),
+			},
+			{
+				Config: testAccDiskPlacementGroupSpread(true),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("yandex_compute_disk_placement_group.pg", "placement_strategy_spread", "true"),
+					resource.TestCheckResourceAttr("yandex_compute_disk_placement_group.pg", "placement_strategy_partitions", "0"),
+				),
+			},
+			{
+				ResourceName:      "yandex_compute_disk_placement_group.pg",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckComputeDiskPlacementGroupDestroy(s *terraform.State) error {
+	config := testAccProvider.Meta().(*Config)
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "yandex_compute_disk_placement_group" {
+			continue
+		}
+
+		_, err := config.sdk.Compute().DiskPlacementGroup().Get(context.Background(), &compute.GetDiskPlacementGroupRequest{
+			DiskPlacementGroupId: rs.Primary.ID,
+		})
+		if err == nil {
+			return fmt.Errorf("Disk Placement Group still exists")
+		}
+	}
+
+	return nil
+}
+
 func testAccCheckNonEmptyDiskPlacementGroup(disk *compute.Disk) resource.TestCheckFunc {
 	return func(state *terraform.State) error {
 		if disk.DiskPlacementPolicy != nil && disk.DiskPlacementPolicy.PlacementGroupId != "" {
@@ -181,3 +250,23 @@ resource yandex_compute_disk_placement_group pg {
 }
 `, instance)
 }
+
+func testAccDiskPlacementGroupPartitions(partitions int) string {
+	// language=tf
+	return fmt.Sprintf(`
+resource yandex_compute_disk_placement_group pg {
+  zone                          = "ru-central1-b"
+  placement_strategy_partitions = %d
+}
+`, partitions)
+}
+
+func testAccDiskPlacementGroupSpread(spread bool) string {
+	// language=tf
+	return fmt.Sprintf(`
+resource yandex_compute_disk_placement_group pg {
+  zone                      = "ru-central1-b"
+  placement_strategy_spread = %t
+}
+`, spread)
+}
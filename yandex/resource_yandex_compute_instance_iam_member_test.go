@@ -0,0 +1,163 @@
+package yandex
+
+import (
+	"fmt"
+	"reflect"
+	"sort"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/yandex-cloud/go-genproto/yandex/cloud/access"
+	"github.com/yandex-cloud/go-genproto/yandex/cloud/compute/v1"
+)
+
+const computeInstanceIamResource = "yandex_compute_instance.foobar"
+
+func TestAccComputeInstanceIamMember_basic(t *testing.T) {
+	var instance compute.Instance
+	instanceName := acctest.RandomWithPrefix("tf-instance-iam")
+	userID := "allUsers"
+	role := "editor"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProviderFactoriesV6,
+		CheckDestroy:             testAccCheckComputeInstanceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccComputeInstanceIamMemberInstance(instanceName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckComputeInstanceExists(computeInstanceIamResource, &instance),
+					testAccCheckComputeInstanceIam(computeInstanceIamResource, role, nil),
+				),
+			},
+			{
+				Config: testAccComputeInstanceIamMemberBasic(instanceName, role, userID),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckComputeInstanceIam(computeInstanceIamResource, role, []string{"system:" + userID}),
+				),
+			},
+			{
+				ResourceName: "yandex_compute_instance_iam_member.test-compute-instance-member",
+				ImportStateIdFunc: func(s *terraform.State) (string, error) {
+					return fmt.Sprintf("%s,%s,system:%s", instance.Id, role, userID), nil
+				},
+				ImportState: true,
+			},
+			{
+				Config: testAccComputeInstanceIamMemberInstance(instanceName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckComputeInstanceIam(computeInstanceIamResource, role, nil),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckComputeInstanceIam(resourceName, role string, members []string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		bindings, err := getComputeInstanceResourceAccessBindings(s, resourceName)
+		if err != nil {
+			return err
+		}
+
+		var roleMembers []string
+		for _, binding := range bindings {
+			if binding.RoleId == role {
+				member := binding.Subject.Type + ":" + binding.Subject.Id
+				roleMembers = append(roleMembers, member)
+			}
+		}
+		sort.Strings(members)
+		sort.Strings(roleMembers)
+
+		if reflect.DeepEqual(members, roleMembers) {
+			return nil
+		}
+
+		return fmt.Errorf("Binding found but expected members is %v, got %v", members, roleMembers)
+	}
+}
+
+func getComputeInstanceResourceAccessBindings(s *terraform.State, resourceName string) ([]*access.AccessBinding, error) {
+	config := testAccProvider.Meta().(*Config)
+
+	rs, ok := s.RootModule().Resources[resourceName]
+	if !ok {
+		return nil, fmt.Errorf("can't find %s in state", resourceName)
+	}
+
+	bindings := []*access.AccessBinding{}
+	pageToken := ""
+
+	for {
+		resp, err := config.sdk.Compute().Instance().ListAccessBindings(config.Context(), &access.ListAccessBindingsRequest{
+			ResourceId: rs.Primary.ID,
+			PageSize:   defaultListSize,
+			PageToken:  pageToken,
+		})
+		if err != nil {
+			return nil, fmt.Errorf("Error retrieving access bindings of Compute Instance %s: %w", rs.Primary.ID, err)
+		}
+
+		bindings = append(bindings, resp.AccessBindings...)
+
+		if resp.NextPageToken == "" {
+			break
+		}
+
+		pageToken = resp.NextPageToken
+	}
+	return bindings, nil
+}
+
+func testAccComputeInstanceIamMemberInstance(instanceName string) string {
+	return fmt.Sprintf(`
+data "yandex_compute_image" "ubuntu" {
+  family = "ubuntu-1804-lts"
+}
+
+resource "yandex_compute_instance" "foobar" {
+  name        = "%s"
+  description = "testAccComputeInstanceIamMember"
+  platform_id = "standard-v2"
+  zone        = "ru-central1-a"
+
+  resources {
+    cores  = 2
+    memory = 2
+  }
+
+  boot_disk {
+    initialize_params {
+      size     = 4
+      image_id = "${data.yandex_compute_image.ubuntu.id}"
+    }
+  }
+
+  network_interface {
+    subnet_id = "${yandex_vpc_subnet.inst-test-subnet.id}"
+  }
+}
+
+resource "yandex_vpc_network" "inst-test-network" {}
+
+resource "yandex_vpc_subnet" "inst-test-subnet" {
+  zone           = "ru-central1-a"
+  network_id     = "${yandex_vpc_network.inst-test-network.id}"
+  v4_cidr_blocks = ["192.168.0.0/24"]
+}
+`, instanceName)
+}
+
+func testAccComputeInstanceIamMemberBasic(instanceName, role, userID string) string {
+	return testAccComputeInstanceIamMemberInstance(instanceName) + fmt.Sprintf(`
+resource "yandex_compute_instance_iam_member" "test-compute-instance-member" {
+  instance_id = yandex_compute_instance.foobar.id
+  role        = "%s"
+  member      = "system:%s"
+}
+`, role, userID)
+}
@@ -9,6 +9,7 @@ import (
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"google.golang.org/genproto/protobuf/field_mask"
+	"google.golang.org/grpc/codes"
 
 	"github.com/yandex-cloud/go-genproto/yandex/cloud/containerregistry/v1"
 	"github.com/yandex-cloud/terraform-provider-yandex/common"
@@ -25,7 +26,7 @@ func resourceYandexContainerRegistry() *schema.Resource {
 		Update: resourceYandexContainerRegistryUpdate,
 		Delete: resourceYandexContainerRegistryDelete,
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			State: resourceYandexContainerRegistryImportState,
 		},
 
 		Timeouts: &schema.ResourceTimeout{
@@ -71,6 +72,22 @@ func resourceYandexContainerRegistry() *schema.Resource {
 				Description: common.ResourceDescriptions["created_at"],
 				Computed:    true,
 			},
+
+			"scan_on_push": {
+				Type:        schema.TypeList,
+				Description: "Vulnerability scanning of images pushed to the registry. For more information, see [the official documentation](https://yandex.cloud/docs/container-registry/concepts/vulnerability-scanner).",
+				Optional:    true,
+				MaxItems:    1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"enabled": {
+							Type:        schema.TypeBool,
+							Description: "Scan images for vulnerabilities when they are pushed to any repository of the registry.",
+							Required:    true,
+						},
+					},
+				},
+			},
 		},
 	}
 }
@@ -123,6 +140,12 @@ func resourceYandexContainerRegistryCreate(d *schema.ResourceData, meta interfac
 		return fmt.Errorf("Container Registry creation failed: %s", err)
 	}
 
+	if d.Get("scan_on_push.0.enabled").(bool) {
+		if err := updateContainerRegistryScanOnPush(ctx, config, d.Id(), true); err != nil {
+			return err
+		}
+	}
+
 	return resourceYandexContainerRegistryRead(d, meta)
 }
 
@@ -143,6 +166,14 @@ func resourceYandexContainerRegistryRead(d *schema.ResourceData, meta interface{
 	d.Set("folder_id", registry.FolderId)
 	d.Set("status", strings.ToLower(registry.Status.String()))
 
+	scanOnPush, err := flattenContainerRegistryScanOnPush(d, config)
+	if err != nil {
+		return err
+	}
+	if err := d.Set("scan_on_push", scanOnPush); err != nil {
+		return err
+	}
+
 	return d.Set("labels", registry.Labels)
 }
 
@@ -168,13 +199,27 @@ func resourceYandexContainerRegistryUpdate(d *schema.ResourceData, meta interfac
 		req.UpdateMask.Paths = append(req.UpdateMask.Paths, "name")
 	}
 
-	if len(req.UpdateMask.Paths) == 0 {
+	if len(req.UpdateMask.Paths) == 0 && !d.HasChange("scan_on_push") {
 		return fmt.Errorf("No fields were updated for Container Registry %s", d.Id())
 	}
 
-	err := makeRegistryUpdateRequest(req, d, meta)
-	if err != nil {
-		return err
+	if len(req.UpdateMask.Paths) != 0 {
+		err := makeRegistryUpdateRequest(req, d, meta)
+		if err != nil {
+			return err
+		}
+	}
+
+	if d.HasChange("scan_on_push") {
+		config := meta.(*Config)
+
+		ctx, cancel := context.WithTimeout(config.Context(), d.Timeout(schema.TimeoutUpdate))
+		defer cancel()
+
+		err := updateContainerRegistryScanOnPush(ctx, config, d.Id(), d.Get("scan_on_push.0.enabled").(bool))
+		if err != nil {
+			return err
+		}
 	}
 
 	return resourceYandexContainerRegistryRead(d, meta)
@@ -229,3 +274,151 @@ func makeRegistryUpdateRequest(req *containerregistry.UpdateRegistryRequest, d *
 
 	return nil
 }
+
+func getContainerRegistryScanPolicy(ctx context.Context, config *Config, registryID string) (*containerregistry.ScanPolicy, error) {
+	policy, err := config.sdk.ContainerRegistry().ScanPolicy().GetByRegistry(ctx, &containerregistry.GetScanPolicyByRegistryRequest{
+		RegistryId: registryID,
+	})
+	if err != nil {
+		if isStatusWithCode(err, codes.NotFound) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("Error while requesting API to get scan policy of Container Registry %q: %s", registryID, err)
+	}
+
+	return policy, nil
+}
+
+// resourceYandexContainerRegistryImportState fills in scan_on_push when the registry has a scan policy,
+// as Read looks at the scan policy only when scan_on_push is managed.
+func resourceYandexContainerRegistryImportState(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	config := meta.(*Config)
+
+	policy, err := getContainerRegistryScanPolicy(config.Context(), config, d.Id())
+	if err != nil {
+		return nil, err
+	}
+
+	if policy != nil {
+		if err := d.Set("scan_on_push", flattenContainerRegistryScanPolicy(policy)); err != nil {
+			return nil, err
+		}
+	}
+
+	return []*schema.ResourceData{d}, nil
+}
+
+// flattenContainerRegistryScanOnPush only looks at the scan policy when scan_on_push is managed,
+// so registries that do not use it do not need access to the scanner API.
+func flattenContainerRegistryScanOnPush(d *schema.ResourceData, config *Config) ([]map[string]interface{}, error) {
+	if len(d.Get("scan_on_push").([]interface{})) == 0 {
+		return nil, nil
+	}
+
+	policy, err := getContainerRegistryScanPolicy(config.Context(), config, d.Id())
+	if err != nil {
+		return nil, err
+	}
+
+	return flattenContainerRegistryScanPolicy(policy), nil
+}
+
+func flattenContainerRegistryScanPolicy(policy *containerregistry.ScanPolicy) []map[string]interface{} {
+	pushRule := policy.GetRules().GetPushRule()
+	enabled := policy != nil && !policy.GetDisabled() && pushRule != nil && !pushRule.GetDisabled()
+
+	return []map[string]interface{}{{"enabled": enabled}}
+}
+
+// updateContainerRegistryScanOnPush enables or disables the on-push rule of the registry scan policy,
+// creating the policy if the registry does not have one yet. Scheduled rescan rules are left as is.
+func updateContainerRegistryScanOnPush(ctx context.Context, config *Config, registryID string, enabled bool) error {
+	policy, err := getContainerRegistryScanPolicy(ctx, config, registryID)
+	if err != nil {
+		return err
+	}
+
+	deleteReq, createReq, updateReq := prepareContainerRegistryScanOnPushRequests(registryID, policy, enabled)
+
+	if deleteReq != nil {
+		op, err := config.sdk.WrapOperation(config.sdk.ContainerRegistry().ScanPolicy().Delete(ctx, deleteReq))
+		if err != nil {
+			return fmt.Errorf("Error while requesting API to delete scan policy of Container Registry %q: %s", registryID, err)
+		}
+
+		if err := op.Wait(ctx); err != nil {
+			return fmt.Errorf("Error deleting scan policy of Container Registry %q: %s", registryID, err)
+		}
+	}
+
+	if createReq != nil {
+		op, err := config.sdk.WrapOperation(config.sdk.ContainerRegistry().ScanPolicy().Create(ctx, createReq))
+		if err != nil {
+			return fmt.Errorf("Error while requesting API to create scan policy of Container Registry %q: %s", registryID, err)
+		}
+
+		if err := op.Wait(ctx); err != nil {
+			return fmt.Errorf("Error creating scan policy of Container Registry %q: %s", registryID, err)
+		}
+	}
+
+	if updateReq != nil {
+		op, err := config.sdk.WrapOperation(config.sdk.ContainerRegistry().ScanPolicy().Update(ctx, updateReq))
+		if err != nil {
+			return fmt.Errorf("Error while requesting API to update scan policy of Container Registry %q: %s", registryID, err)
+		}
+
+		if err := op.Wait(ctx); err != nil {
+			return fmt.Errorf("Error updating scan policy of Container Registry %q: %s", registryID, err)
+		}
+	}
+
+	return nil
+}
+
+// prepareContainerRegistryScanOnPushRequests returns the requests to bring the on-push rule of the scan policy
+// to the wanted state, to be sent in the order they are returned. The API cannot turn on a policy which is
+// disabled as a whole, so such a policy is deleted and created again with the same name and rules.
+func prepareContainerRegistryScanOnPushRequests(registryID string, policy *containerregistry.ScanPolicy, enabled bool) (
+	*containerregistry.DeleteScanPolicyRequest,
+	*containerregistry.CreateScanPolicyRequest,
+	*containerregistry.UpdateScanPolicyRequest,
+) {
+	rules := &containerregistry.ScanRules{
+		PushRule: &containerregistry.PushRule{
+			RepositoryPrefixes: []string{"*"},
+			Disabled:           !enabled,
+		},
+		ScheduleRules: policy.GetRules().GetScheduleRules(),
+	}
+	if prefixes := policy.GetRules().GetPushRule().GetRepositoryPrefixes(); len(prefixes) != 0 {
+		rules.PushRule.RepositoryPrefixes = prefixes
+	}
+
+	if policy == nil {
+		if !enabled {
+			return nil, nil, nil
+		}
+		return nil, &containerregistry.CreateScanPolicyRequest{
+			RegistryId: registryID,
+			Rules:      rules,
+		}, nil
+	}
+
+	if policy.GetDisabled() && enabled {
+		return &containerregistry.DeleteScanPolicyRequest{
+			ScanPolicyId: policy.GetId(),
+		}, &containerregistry.CreateScanPolicyRequest{
+			RegistryId:  registryID,
+			Name:        policy.GetName(),
+			Description: policy.GetDescription(),
+			Rules:       rules,
+		}, nil
+	}
+
+	return nil, nil, &containerregistry.UpdateScanPolicyRequest{
+		ScanPolicyId: policy.GetId(),
+		UpdateMask:   &field_mask.FieldMask{Paths: []string{"rules"}},
+		Rules:        rules,
+	}
+}
@@ -0,0 +1,70 @@
+package yandex
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+
+	"github.com/yandex-cloud/go-genproto/yandex/cloud/containerregistry/v1"
+)
+
+func TestAccContainerRegistryIamMember_basic(t *testing.T) {
+	var registry containerregistry.Registry
+	registryName := acctest.RandomWithPrefix("tf-container-registry")
+
+	role := "container-registry.images.puller"
+	userID := "system:allUsers"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProviderFactoriesV6,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccContainerRegistry(registryName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckContainerRegistryExists(containerRegistryResource, &registry),
+					testAccCheckContainerRegistryEmptyIam(containerRegistryResource),
+				),
+			},
+			{
+				Config: testAccContainerRegistryIamMemberBasic(registryName, role, userID),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckContainerRegistryExists(containerRegistryResource, &registry),
+					testAccCheckContainerRegistryIam(containerRegistryResource, role, []string{userID}),
+				),
+			},
+			{
+				ResourceName: "yandex_container_registry_iam_member.puller",
+				ImportStateIdFunc: func(s *terraform.State) (string, error) {
+					return registry.Id + "," + role + "," + userID, nil
+				},
+				ImportState:                          true,
+				ImportStateVerifyIdentifierAttribute: "registry_id",
+			},
+			{
+				Config: testAccContainerRegistry(registryName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckContainerRegistryExists(containerRegistryResource, &registry),
+					testAccCheckContainerRegistryEmptyIam(containerRegistryResource),
+				),
+			},
+		},
+	})
+}
+
+func testAccContainerRegistryIamMemberBasic(registryName, role, member string) string {
+	return fmt.Sprintf(`
+resource "yandex_container_registry" "test-registry" {
+  name       = "%s"
+}
+
+resource "yandex_container_registry_iam_member" "puller" {
+  registry_id = yandex_container_registry.test-registry.id
+  role        = "%s"
+  member      = "%s"
+}
+`, registryName, role, member)
+}
@@ -5,13 +5,18 @@ import (
 	"fmt"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/hashicorp/go-multierror"
 	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/genproto/protobuf/field_mask"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/durationpb"
 
 	"github.com/yandex-cloud/go-genproto/yandex/cloud/containerregistry/v1"
 )
@@ -247,6 +252,148 @@ func TestAccContainerRegistry_updateLabelValue(t *testing.T) {
 	})
 }
 
+func TestAccContainerRegistry_scanOnPush(t *testing.T) {
+	t.Parallel()
+
+	registryName := fmt.Sprintf("tf-test-%s", acctest.RandString(10))
+	var registry containerregistry.Registry
+	folderID := getExampleFolderID()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckContainerRegistryDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccContainerRegistry_scanOnPush(registryName, folderID, true),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckContainerRegistryExists("yandex_container_registry.foobar", &registry),
+					resource.TestCheckResourceAttr("yandex_container_registry.foobar", "scan_on_push.0.enabled", "true"),
+					testAccCheckContainerRegistryScanOnPush(&registry, true),
+				),
+			},
+			{
+				Config: testAccContainerRegistry_scanOnPush(registryName, folderID, false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckContainerRegistryExists("yandex_container_registry.foobar", &registry),
+					resource.TestCheckResourceAttr("yandex_container_registry.foobar", "scan_on_push.0.enabled", "false"),
+					testAccCheckContainerRegistryScanOnPush(&registry, false),
+				),
+			},
+			{
+				Config: testAccContainerRegistry_scanOnPush(registryName, folderID, true),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("yandex_container_registry.foobar", "scan_on_push.0.enabled", "true"),
+					testAccCheckContainerRegistryScanOnPush(&registry, true),
+				),
+			},
+			{
+				ResourceName:      "yandex_container_registry.foobar",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestPrepareContainerRegistryScanOnPushRequests(t *testing.T) {
+	scheduleRules := []*containerregistry.ScheduledRule{
+		{RepositoryPrefixes: []string{"app"}, RescanPeriod: durationpb.New(24 * time.Hour)},
+	}
+
+	cases := []struct {
+		name       string
+		policy     *containerregistry.ScanPolicy
+		enabled    bool
+		wantDelete *containerregistry.DeleteScanPolicyRequest
+		wantCreate *containerregistry.CreateScanPolicyRequest
+		wantUpdate *containerregistry.UpdateScanPolicyRequest
+	}{
+		{
+			name:    "no policy, disable",
+			enabled: false,
+		},
+		{
+			name:    "no policy, enable",
+			enabled: true,
+			wantCreate: &containerregistry.CreateScanPolicyRequest{
+				RegistryId: "registry-id",
+				Rules: &containerregistry.ScanRules{
+					PushRule: &containerregistry.PushRule{RepositoryPrefixes: []string{"*"}},
+				},
+			},
+		},
+		{
+			name: "enabled policy, disable",
+			policy: &containerregistry.ScanPolicy{
+				Id: "policy-id",
+				Rules: &containerregistry.ScanRules{
+					PushRule:      &containerregistry.PushRule{RepositoryPrefixes: []string{"app"}},
+					ScheduleRules: scheduleRules,
+				},
+			},
+			enabled: false,
+			wantUpdate: &containerregistry.UpdateScanPolicyRequest{
+				ScanPolicyId: "policy-id",
+				UpdateMask:   &field_mask.FieldMask{Paths: []string{"rules"}},
+				Rules: &containerregistry.ScanRules{
+					PushRule:      &containerregistry.PushRule{RepositoryPrefixes: []string{"app"}, Disabled: true},
+					ScheduleRules: scheduleRules,
+				},
+			},
+		},
+		{
+			name: "disabled policy, enable",
+			policy: &containerregistry.ScanPolicy{
+				Id:          "policy-id",
+				Name:        "policy",
+				Description: "description",
+				Disabled:    true,
+				Rules: &containerregistry.ScanRules{
+					PushRule:      &containerregistry.PushRule{RepositoryPrefixes: []string{"*"}},
+					ScheduleRules: scheduleRules,
+				},
+			},
+			enabled:    true,
+			wantDelete: &containerregistry.DeleteScanPolicyRequest{ScanPolicyId: "policy-id"},
+			wantCreate: &containerregistry.CreateScanPolicyRequest{
+				RegistryId:  "registry-id",
+				Name:        "policy",
+				Description: "description",
+				Rules: &containerregistry.ScanRules{
+					PushRule:      &containerregistry.PushRule{RepositoryPrefixes: []string{"*"}},
+					ScheduleRules: scheduleRules,
+				},
+			},
+		},
+		{
+			name: "disabled policy, disable",
+			policy: &containerregistry.ScanPolicy{
+				Id:       "policy-id",
+				Disabled: true,
+			},
+			enabled: false,
+			wantUpdate: &containerregistry.UpdateScanPolicyRequest{
+				ScanPolicyId: "policy-id",
+				UpdateMask:   &field_mask.FieldMask{Paths: []string{"rules"}},
+				Rules: &containerregistry.ScanRules{
+					PushRule: &containerregistry.PushRule{RepositoryPrefixes: []string{"*"}, Disabled: true},
+				},
+			},
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			deleteReq, createReq, updateReq := prepareContainerRegistryScanOnPushRequests("registry-id", tc.policy, tc.enabled)
+
+			assert.True(t, proto.Equal(tc.wantDelete, deleteReq), "expected delete request %v, got %v", tc.wantDelete, deleteReq)
+			assert.True(t, proto.Equal(tc.wantCreate, createReq), "expected create request %v, got %v", tc.wantCreate, createReq)
+			assert.True(t, proto.Equal(tc.wantUpdate, updateReq), "expected update request %v, got %v", tc.wantUpdate, updateReq)
+		})
+	}
+}
+
 func testAccCheckContainerRegistryDestroy(s *terraform.State) error {
 	config := testAccProvider.Meta().(*Config)
 
@@ -343,6 +490,25 @@ func testAccCheckContainerRegistryDoesNotContainLabel(registry *containerregistr
 	}
 }
 
+func testAccCheckContainerRegistryScanOnPush(registry *containerregistry.Registry, enabled bool) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		config := testAccProvider.Meta().(*Config)
+
+		policy, err := getContainerRegistryScanPolicy(context.Background(), config, registry.Id)
+		if err != nil {
+			return err
+		}
+
+		pushRule := policy.GetRules().GetPushRule()
+		actual := policy != nil && !policy.GetDisabled() && pushRule != nil && !pushRule.GetDisabled()
+		if actual != enabled {
+			return fmt.Errorf("Container Registry scan on push is %t, expected %t", actual, enabled)
+		}
+
+		return nil
+	}
+}
+
 func testAccCheckRegistyIdsEqual(registryID *string, afterUpdateRegistryID *string) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		if *registryID != *afterUpdateRegistryID {
@@ -379,3 +545,16 @@ resource "yandex_container_registry" "foobar" {
 }
 `, name, folderID, labelValue)
 }
+
+func testAccContainerRegistry_scanOnPush(name, folderID string, enabled bool) string {
+	return fmt.Sprintf(`
+resource "yandex_container_registry" "foobar" {
+  name      = "%s"
+  folder_id = "%s"
+
+  scan_on_push {
+    enabled = %t
+  }
+}
+`, name, folderID, enabled)
+}
@@ -863,6 +863,15 @@ func resourceYandexDatatransferTransferRead(d *schema.ResourceData, meta interfa
 		return handleNotFoundError(err, d, fmt.Sprintf("transfer %q", d.Id()))
 	}
 
+	if err := d.Set("on_create_activate_mode", internalMessageActivateMode); err != nil {
+		log.Printf("[ERROR] failed set field activate_mode: %s", err)
+		return err
+	}
+
+	return flattenDatatransferTransfer(d, resp)
+}
+
+func flattenDatatransferTransfer(d *schema.ResourceData, resp *datatransfer.Transfer) error {
 	if err := d.Set("description", resp.GetDescription()); err != nil {
 		log.Printf("[ERROR] failed set field description: %s", err)
 		return err
@@ -895,10 +904,6 @@ func resourceYandexDatatransferTransferRead(d *schema.ResourceData, meta interfa
 		log.Printf("[ERROR] failed set field target_id: %s", err)
 		return err
 	}
-	if err := d.Set("on_create_activate_mode", internalMessageActivateMode); err != nil {
-		log.Printf("[ERROR] failed set field activate_mode: %s", err)
-		return err
-	}
 
 	transformation, err := flattenDatatransferTransferTransformation(d, resp.GetTransformation())
 	if err != nil {
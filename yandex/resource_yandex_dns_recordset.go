@@ -4,6 +4,8 @@ import (
 	"context"
 	"fmt"
 	"log"
+	"regexp"
+	"strconv"
 	"strings"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -22,6 +24,8 @@ func resourceYandexDnsRecordSet() *schema.Resource {
 			State: resourceDnsRecordSetImportState,
 		},
 
+		CustomizeDiff: validateDnsRecordSetData,
+
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(yandexDnsDefaultTimeout),
 			Update: schema.DefaultTimeout(yandexDnsDefaultTimeout),
@@ -63,7 +67,7 @@ func resourceYandexDnsRecordSet() *schema.Resource {
 
 			"data": {
 				Type:        schema.TypeSet,
-				Description: "The string data for the records in this record set.",
+				Description: "The string data for the records in this record set. `CAA` records must use the `flags tag value` format, e.g. `0 issue \"letsencrypt.org\"`.",
 				Required:    true,
 				MinItems:    1,
 				MaxItems:    100,
@@ -258,6 +262,40 @@ func resourceDnsRecordSetImportState(d *schema.ResourceData, _ interface{}) ([]*
 	return []*schema.ResourceData{d}, nil
 }
 
+var dnsCAARecordTagRegexp = regexp.MustCompile(`^[a-zA-Z0-9]+$`)
+
+func validateDnsRecordSetData(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	if !strings.EqualFold(d.Get("type").(string), "CAA") || !d.NewValueKnown("data") {
+		return nil
+	}
+
+	for _, v := range d.Get("data").(*schema.Set).List() {
+		if err := validateDnsCAARecordData(v.(string)); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// validateDnsCAARecordData checks CAA record data against the "flags tag value" format of RFC 8659.
+func validateDnsCAARecordData(data string) error {
+	parts := strings.Fields(data)
+	if len(parts) < 3 {
+		return fmt.Errorf("invalid CAA record data %q: expected \"flags tag value\" format", data)
+	}
+
+	if flags, err := strconv.Atoi(parts[0]); err != nil || flags < 0 || flags > 255 {
+		return fmt.Errorf("invalid CAA record data %q: flags must be an integer between 0 and 255", data)
+	}
+
+	if !dnsCAARecordTagRegexp.MatchString(parts[1]) {
+		return fmt.Errorf("invalid CAA record data %q: tag must consist of letters and digits", data)
+	}
+
+	return nil
+}
+
 func rsId(d *schema.ResourceData) string {
 	return fmt.Sprintf("%s %s", d.Get("type").(string), d.Get("name"))
 }
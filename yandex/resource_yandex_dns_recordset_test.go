@@ -3,6 +3,7 @@ package yandex
 import (
 	"context"
 	"fmt"
+	"regexp"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
@@ -74,6 +75,63 @@ func TestAccDNSRecordSet_short(t *testing.T) {
 	})
 }
 
+func TestAccDNSRecordSet_caa(t *testing.T) {
+	t.Parallel()
+
+	var rs dns.RecordSet
+	zoneName := acctest.RandomWithPrefix("tf-dns-zone")
+	fqdn := acctest.RandomWithPrefix("tf-test") + ".dnstest.test."
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckVPCAddressDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccDNSRecordSetCAA(zoneName, fqdn, `issue \"letsencrypt.org\"`),
+				ExpectError: regexp.MustCompile("invalid CAA record data"),
+			},
+			{
+				Config: testAccDNSRecordSetCAA(zoneName, fqdn, `0 issue \"letsencrypt.org\"`),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDNSRecordSetExists("yandex_dns_recordset.caa", &rs),
+					resource.TestCheckResourceAttr("yandex_dns_recordset.caa", "type", "CAA"),
+					resource.TestCheckResourceAttr("yandex_dns_recordset.caa", "name", fqdn),
+					testAccCheckDnsRecordsetData(&rs, `0 issue "letsencrypt.org"`, true),
+				),
+			},
+			{
+				ResourceName:      "yandex_dns_recordset.caa",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestValidateDnsCAARecordData(t *testing.T) {
+	cases := []struct {
+		data    string
+		isValid bool
+	}{
+		{data: `0 issue "letsencrypt.org"`, isValid: true},
+		{data: `128 iodef "mailto:security@example.com"`, isValid: true},
+		{data: `0 issuewild ";"`, isValid: true},
+		{data: `issue "letsencrypt.org"`, isValid: false},
+		{data: `256 issue "letsencrypt.org"`, isValid: false},
+		{data: `-1 issue "letsencrypt.org"`, isValid: false},
+		{data: `0 is-sue "letsencrypt.org"`, isValid: false},
+		{data: `0 issue`, isValid: false},
+	}
+
+	for _, tc := range cases {
+		err := validateDnsCAARecordData(tc.data)
+		if isValid := err == nil; isValid != tc.isValid {
+			t.Errorf("validation of %q: expected valid %t, got error %v", tc.data, tc.isValid, err)
+		}
+	}
+}
+
 func TestAccDNSRecordSet_zoneChange(t *testing.T) {
 	t.Parallel()
 
@@ -313,3 +371,21 @@ resource "yandex_dns_recordset" "rs2" {
 }
 `, name, fqdn)
 }
+
+func testAccDNSRecordSetCAA(name, fqdn, data string) string {
+	return fmt.Sprintf(`
+resource "yandex_dns_zone" "zone1" {
+  name        = "%[1]s"
+  description = "desc"
+  zone        = "%[2]s"
+}
+
+resource "yandex_dns_recordset" "caa" {
+  zone_id = yandex_dns_zone.zone1.id
+  name    = "%[2]s"
+  type    = "CAA"
+  ttl     = 600
+  data    = ["%[3]s"]
+}
+`, name, fqdn, data)
+}
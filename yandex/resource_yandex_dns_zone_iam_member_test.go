@@ -0,0 +1,90 @@
+package yandex
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/yandex-cloud/go-genproto/yandex/cloud/dns/v1"
+)
+
+func TestAccDNSZoneIamMember_basic(t *testing.T) {
+	var dnsZone dns.DnsZone
+	dnsZoneName := acctest.RandomWithPrefix("tf-dns-zone")
+	accountName := "sa" + acctest.RandString(10)
+
+	role := "dns.editor"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProviderFactoriesV6,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDNSZoneIamMemberServiceAccount(dnsZoneName, accountName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDnsZoneExists(dnsZoneResource, &dnsZone),
+					testAccCheckDNSZoneEmptyIam(dnsZoneResource),
+				),
+			},
+			{
+				Config: testAccDNSZoneIamMemberBasic(dnsZoneName, accountName, role),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDNSZoneIamMemberServiceAccount(dnsZoneResource, role),
+				),
+			},
+			{
+				ResourceName: "yandex_dns_zone_iam_member.editor",
+				ImportStateIdFunc: func(s *terraform.State) (string, error) {
+					sa, ok := s.RootModule().Resources["yandex_iam_service_account.test-sa"]
+					if !ok {
+						return "", fmt.Errorf("can't find service account in state")
+					}
+					return fmt.Sprintf("%s,%s,serviceAccount:%s", dnsZone.Id, role, sa.Primary.ID), nil
+				},
+				ImportState: true,
+			},
+			{
+				Config: testAccDNSZoneIamMemberServiceAccount(dnsZoneName, accountName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDNSZoneEmptyIam(dnsZoneResource),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckDNSZoneIamMemberServiceAccount(resourceName, role string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		sa, ok := s.RootModule().Resources["yandex_iam_service_account.test-sa"]
+		if !ok {
+			return fmt.Errorf("can't find service account in state")
+		}
+
+		return testAccCheckDNSZoneIam(resourceName, role, []string{"serviceAccount:" + sa.Primary.ID})(s)
+	}
+}
+
+func testAccDNSZoneIamMemberServiceAccount(dnsZoneName, accountName string) string {
+	return fmt.Sprintf(`
+resource "yandex_dns_zone" "test-key" {
+  name = "%s"
+  zone = "t.e.s.t.z.o.n.e."
+}
+
+resource "yandex_iam_service_account" "test-sa" {
+  name = "%s"
+}
+`, dnsZoneName, accountName)
+}
+
+func testAccDNSZoneIamMemberBasic(dnsZoneName, accountName, role string) string {
+	return testAccDNSZoneIamMemberServiceAccount(dnsZoneName, accountName) + fmt.Sprintf(`
+resource "yandex_dns_zone_iam_member" "editor" {
+  dns_zone_id = yandex_dns_zone.test-key.id
+  role        = "%s"
+  member      = "serviceAccount:${yandex_iam_service_account.test-sa.id}"
+}
+`, role)
+}
@@ -3,6 +3,7 @@ package yandex
 import (
 	"context"
 	"fmt"
+	"regexp"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
@@ -207,6 +208,11 @@ func TestAccDNSZone_deletionProtection(t *testing.T) {
 					resource.TestCheckResourceAttr("yandex_dns_zone.zone1", "deletion_protection", "true"),
 				),
 			},
+			// trigger zone replacement by changing its fqdn
+			{
+				Config:      testAccDNSZoneDeletionProtectionOn(zoneName, "replaced-"+fqdn),
+				ExpectError: regexp.MustCompile("(?i)deletion.protection"),
+			},
 			{
 				Config: testAccDNSZoneDeletionProtectionOff(zoneName, fqdn),
 				Check: resource.ComposeTestCheckFunc(
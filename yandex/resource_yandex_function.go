@@ -4,6 +4,8 @@ import (
 	"archive/zip"
 	"bytes"
 	"context"
+	"crypto/sha256"
+	"encoding/hex"
 	"fmt"
 	"io"
 	"io/ioutil"
@@ -196,11 +198,29 @@ func resourceYandexFunction() *schema.Resource {
 				Computed:    true,
 			},
 
+			"version_id": {
+				Type:        schema.TypeString,
+				Description: "ID of the last deployed version of Yandex Cloud Function.",
+				Computed:    true,
+			},
+
+			"image_sha256": {
+				Type:        schema.TypeString,
+				Description: "SHA256 hash of the deployment package of the version deployed by Terraform. It is computed from the package sent to the API, so it is empty after import.",
+				Computed:    true,
+			},
+
 			"created_at": {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
 
+			"http_invoke_url": {
+				Type:        schema.TypeString,
+				Description: "Invoke URL for the Yandex Cloud Function.",
+				Computed:    true,
+			},
+
 			"secrets": {
 				Type:        schema.TypeList,
 				Description: "Secrets for Yandex Cloud Function.",
@@ -446,10 +466,11 @@ func resourceYandexFunction() *schema.Resource {
 			},
 
 			"concurrency": {
-				Type:        schema.TypeInt,
-				Description: "The maximum number of requests processed by a function instance at the same time.",
-				Optional:    true,
-				Computed:    true,
+				Type:         schema.TypeInt,
+				Description:  "The maximum number of requests processed by a function instance at the same time. Must be between 0 and 16.",
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.IntBetween(0, 16),
 			},
 
 			"metadata_options": {
@@ -534,9 +555,13 @@ func resourceYandexFunctionCreate(ctx context.Context, d *schema.ResourceData, m
 	var diags diag.Diagnostics
 	if versionReq != nil {
 		versionReq.FunctionId = md.FunctionId
-		diags = resourceYandexFunctionDiagsFromCreateVersionError(
-			resourceYandexFunctionCreateVersion(ctx, config.sdk, versionReq),
-		)
+		version, err := resourceYandexFunctionCreateVersion(ctx, config.sdk, versionReq)
+		if err == nil {
+			d.Set("version_id", version.Id)
+			// API does not report hash of the deployment package, so it is kept in state as it was sent on version creation.
+			d.Set("image_sha256", functionVersionPackageSha256(versionReq))
+		}
+		diags = resourceYandexFunctionDiagsFromCreateVersionError(err)
 	}
 
 	return append(diags, resourceYandexFunctionRead(ctx, d, meta)...)
@@ -559,12 +584,36 @@ func resourceYandexFunctionCreateVersion(
 	ctx context.Context,
 	sdk *ycsdk.SDK,
 	req *functions.CreateFunctionVersionRequest,
-) error {
+) (*functions.Version, error) {
 	op, err := sdk.WrapOperation(sdk.Serverless().Functions().Function().CreateVersion(ctx, req))
 	if err != nil {
-		return err
+		return nil, err
 	}
-	return op.Wait(ctx)
+	if err := op.Wait(ctx); err != nil {
+		return nil, err
+	}
+
+	resp, err := op.Response()
+	if err != nil {
+		return nil, err
+	}
+
+	version, ok := resp.(*functions.Version)
+	if !ok {
+		return nil, fmt.Errorf("could not get Yandex Cloud Function version from create version operation response")
+	}
+	return version, nil
+}
+
+func functionVersionPackageSha256(req *functions.CreateFunctionVersionRequest) string {
+	switch source := req.PackageSource.(type) {
+	case *functions.CreateFunctionVersionRequest_Package:
+		return source.Package.GetSha256()
+	case *functions.CreateFunctionVersionRequest_Content:
+		sum := sha256.Sum256(source.Content)
+		return hex.EncodeToString(sum[:])
+	}
+	return ""
 }
 
 func resourceYandexFunctionUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
@@ -633,9 +682,12 @@ func resourceYandexFunctionUpdate(ctx context.Context, d *schema.ResourceData, m
 	var diags diag.Diagnostics
 	if versionReq != nil {
 		versionReq.FunctionId = d.Id()
-		diags = resourceYandexFunctionDiagsFromCreateVersionError(
-			resourceYandexFunctionCreateVersion(ctx, config.sdk, versionReq),
-		)
+		version, err := resourceYandexFunctionCreateVersion(ctx, config.sdk, versionReq)
+		if err == nil {
+			d.Set("version_id", version.Id)
+			d.Set("image_sha256", functionVersionPackageSha256(versionReq))
+		}
+		diags = resourceYandexFunctionDiagsFromCreateVersionError(err)
 	}
 	d.Partial(false)
 
@@ -662,6 +714,10 @@ func resourceYandexFunctionRead(ctx context.Context, d *schema.ResourceData, met
 		return diag.Errorf("Failed to get latest version of Yandex Function: %s", err)
 	}
 
+	if version != nil {
+		d.Set("version_id", version.Id)
+	}
+
 	return diag.FromErr(flattenYandexFunction(d, function, version, false))
 }
 
@@ -801,26 +857,8 @@ func expandLastVersion(d *schema.ResourceData) (*functions.CreateFunctionVersion
 			versionReq.Tag = append(versionReq.Tag, v)
 		}
 	}
-	if _, ok := d.GetOk("package"); ok {
-		pkg := &functions.Package{
-			BucketName: d.Get("package.0.bucket_name").(string),
-			ObjectName: d.Get("package.0.object_name").(string),
-		}
-		if v, ok := d.GetOk("package.0.sha_256"); ok {
-			pkg.Sha256 = v.(string)
-		}
-		versionReq.PackageSource = &functions.CreateFunctionVersionRequest_Package{Package: pkg}
-	} else if _, ok := d.GetOk("content"); ok {
-		content, err := ZipPathToBytes(d.Get("content.0.zip_filename").(string))
-		if err != nil {
-			return nil, fmt.Errorf("Cannot define content for Yandex Cloud Function: %s", err)
-		}
-		if size := len(content); size > versionCreateSourceContentMaxBytes {
-			return nil, fmt.Errorf("Zip archive content size %v exceeds the maximum size %v, use object storage to upload the content", size, versionCreateSourceContentMaxBytes)
-		}
-		versionReq.PackageSource = &functions.CreateFunctionVersionRequest_Content{Content: content}
-	} else {
-		return nil, fmt.Errorf("Package or content option must be present for Yandex Cloud Function")
+	if err := expandFunctionVersionPackageSource(d, versionReq); err != nil {
+		return nil, err
 	}
 	if v, ok := d.GetOk("secrets"); ok {
 		secretsList := v.([]interface{})
@@ -940,6 +978,32 @@ func expandLastVersion(d *schema.ResourceData) (*functions.CreateFunctionVersion
 	return versionReq, nil
 }
 
+func expandFunctionVersionPackageSource(d *schema.ResourceData, versionReq *functions.CreateFunctionVersionRequest) error {
+	if _, ok := d.GetOk("package"); ok {
+		pkg := &functions.Package{
+			BucketName: d.Get("package.0.bucket_name").(string),
+			ObjectName: d.Get("package.0.object_name").(string),
+		}
+		if v, ok := d.GetOk("package.0.sha_256"); ok {
+			pkg.Sha256 = v.(string)
+		}
+		versionReq.PackageSource = &functions.CreateFunctionVersionRequest_Package{Package: pkg}
+	} else if _, ok := d.GetOk("content"); ok {
+		content, err := ZipPathToBytes(d.Get("content.0.zip_filename").(string))
+		if err != nil {
+			return fmt.Errorf("Cannot define content for Yandex Cloud Function: %s", err)
+		}
+		if size := len(content); size > versionCreateSourceContentMaxBytes {
+			return fmt.Errorf("Zip archive content size %v exceeds the maximum size %v, use object storage to upload the content", size, versionCreateSourceContentMaxBytes)
+		}
+		versionReq.PackageSource = &functions.CreateFunctionVersionRequest_Content{Content: content}
+	} else {
+		return fmt.Errorf("Package or content option must be present for Yandex Cloud Function")
+	}
+
+	return nil
+}
+
 func expandFunctionMetadataOptions(d *schema.ResourceData) *functions.MetadataOptions {
 	metadataOptions := functions.MetadataOptions{}
 	if v, ok := d.GetOk("metadata_options.0.gce_http_endpoint"); ok {
@@ -992,6 +1056,7 @@ func flattenYandexFunction(
 	d.Set("description", function.Description)
 	d.Set("created_at", getTimestamp(function.CreatedAt))
 	d.Set("labels", function.Labels)
+	d.Set("http_invoke_url", function.HttpInvokeUrl)
 
 	if version == nil {
 		return nil
@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"os"
+	"regexp"
 	"strconv"
 	"strings"
 	"testing"
@@ -293,7 +294,9 @@ func TestAccYandexFunction_full(t *testing.T) {
 				testYandexFunctionContainsEnv(functionResource, params.envKey, params.envValue),
 				testYandexFunctionContainsTag(functionResource, params.tags),
 				resource.TestCheckResourceAttrSet(functionResource, "version"),
+				resource.TestCheckResourceAttrPair(functionResource, "version_id", functionResource, "version"),
 				resource.TestCheckResourceAttrSet(functionResource, "image_size"),
+				resource.TestCheckResourceAttrSet(functionResource, "image_sha256"),
 				resource.TestCheckResourceAttrSet(functionResource, "secrets.0.id"),
 				resource.TestCheckResourceAttrSet(functionResource, "secrets.0.version_id"),
 				resource.TestCheckResourceAttr(functionResource, "secrets.0.key", params.secret.secretKey),
@@ -342,6 +345,58 @@ func TestAccYandexFunction_full(t *testing.T) {
 	})
 }
 
+func TestAccYandexFunction_concurrency(t *testing.T) {
+	t.Parallel()
+
+	var version *functions.Version
+	resourceName := "test-function"
+	resourcePath := "yandex_function." + resourceName
+	functionName := acctest.RandomWithPrefix("tf-function-concurrency")
+
+	newConfig := func(concurrency int) string {
+		sb := &strings.Builder{}
+		testWriteResourceYandexFunction(
+			sb,
+			resourceName,
+			functionName,
+			"user_hash",
+			128,
+			"main",
+			"python37",
+			"test-fixtures/serverless/main.zip",
+			testResourceYandexFunctionOptionFactory.WithConcurrency(concurrency),
+		)
+		return sb.String()
+	}
+
+	outOfRangeStep := func(concurrency int) resource.TestStep {
+		return resource.TestStep{
+			Config:      newConfig(concurrency),
+			PlanOnly:    true,
+			ExpectError: regexp.MustCompile(fmt.Sprintf(`expected concurrency to be in the range \(0 - 16\), got %d`, concurrency)),
+		}
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProviderFactoriesV6,
+		CheckDestroy:             testYandexFunctionDestroy,
+		Steps: []resource.TestStep{
+			outOfRangeStep(17),
+			outOfRangeStep(-1),
+			{
+				Config: newConfig(2),
+				Check: resource.ComposeTestCheckFunc(
+					testYandexFunctionVersionExists(resourcePath, &version),
+					resource.TestCheckResourceAttr(resourcePath, "concurrency", "2"),
+					testYandexFunctionVersionConcurrency(&version, 2),
+				),
+			},
+			functionImportTestStep(),
+		},
+	})
+}
+
 func TestAccYandexFunction_logOptions(t *testing.T) {
 	t.Parallel()
 
@@ -377,7 +432,7 @@ func TestAccYandexFunction_logOptions(t *testing.T) {
 			ImportState:       true,
 			ImportStateVerify: true,
 			ImportStateVerifyIgnore: []string{
-				"content", "package", "image_size", "user_hash", "storage_mounts",
+				"content", "package", "image_size", "image_sha256", "user_hash", "storage_mounts",
 			},
 			Check: resource.ComposeTestCheckFunc(extraChecks...),
 		}
@@ -582,7 +637,7 @@ func functionImportTestStep() resource.TestStep {
 		ImportState:       true,
 		ImportStateVerify: true,
 		ImportStateVerifyIgnore: []string{
-			"content", "package", "image_size", "user_hash", "storage_mounts",
+			"content", "package", "image_size", "image_sha256", "user_hash", "storage_mounts",
 		},
 	}
 }
@@ -797,6 +852,15 @@ func testYandexFunctionVersionLogOptionsPtr(
 	}
 }
 
+func testYandexFunctionVersionConcurrency(versionPtr **functions.Version, expected int64) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		if actual := (*versionPtr).GetConcurrency(); actual != expected {
+			return fmt.Errorf("expected function version concurrency %d, got %d", expected, actual)
+		}
+		return nil
+	}
+}
+
 func testYandexFunctionContainsTag(name, value string) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		resources, ok := s.RootModule().Resources[name]
@@ -1056,6 +1120,7 @@ type testResourceYandexFunctionOptions struct {
 	description      *string
 	executionTimeout *string
 	logOptions       *testResourceYandexFunctionOptionsLogOptions
+	concurrency      *int
 }
 
 type testResourceYandexFunctionOptionsLogOptions struct {
@@ -1099,6 +1164,12 @@ func (testResourceYandexFunctionOptionFactoryImpl) WithLogOptions(
 	}
 }
 
+func (testResourceYandexFunctionOptionFactoryImpl) WithConcurrency(concurrency int) testResourceYandexFunctionOption {
+	return func(o *testResourceYandexFunctionOptions) {
+		o.concurrency = &concurrency
+	}
+}
+
 func testWriteResourceYandexFunction(
 	sb *strings.Builder,
 	resourceName string,
@@ -1132,6 +1203,9 @@ func testWriteResourceYandexFunction(
 	if executionTimeout := o.executionTimeout; executionTimeout != nil {
 		fprintfLn(sb, "  execution_timeout = \"%s\"", *executionTimeout)
 	}
+	if concurrency := o.concurrency; concurrency != nil {
+		fprintfLn(sb, "  concurrency = %d", *concurrency)
+	}
 	fprintfLn(sb, "  content {")
 	fprintfLn(sb, "    zip_filename = \"%s\"", zipFilename)
 	fprintfLn(sb, "  }")
@@ -0,0 +1,362 @@
+package yandex
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"github.com/c2h5oh/datasize"
+	"github.com/golang/protobuf/ptypes/duration"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/yandex-cloud/go-genproto/yandex/cloud/serverless/functions/v1"
+	"github.com/yandex-cloud/terraform-provider-yandex/common"
+)
+
+func resourceYandexFunctionVersion() *schema.Resource {
+	return &schema.Resource{
+		Description: "Allows management of a single version of [Yandex Cloud Function](https://yandex.cloud/docs/functions). " +
+			"Unlike versions created by `yandex_function`, several versions managed by this resource can exist simultaneously.\n\n" +
+			"~> Do not manage the code of the same function with both `yandex_function` and `yandex_function_version`, as the resulting versions will conflict over the `$latest` tag.\n",
+
+		CreateContext: resourceYandexFunctionVersionCreate,
+		ReadContext:   resourceYandexFunctionVersionRead,
+		UpdateContext: resourceYandexFunctionVersionUpdate,
+		DeleteContext: resourceYandexFunctionVersionDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(yandexFunctionDefaultTimeout),
+			Update: schema.DefaultTimeout(yandexFunctionDefaultTimeout),
+			Delete: schema.DefaultTimeout(yandexFunctionDefaultTimeout),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"function_id": {
+				Type:        schema.TypeString,
+				Description: "ID of the Yandex Cloud Function to create the version for.",
+				Required:    true,
+				ForceNew:    true,
+			},
+
+			"runtime": {
+				Type:        schema.TypeString,
+				Description: "Runtime for the function version.",
+				Required:    true,
+				ForceNew:    true,
+			},
+
+			"entrypoint": {
+				Type:        schema.TypeString,
+				Description: "Entrypoint for the function version.",
+				Required:    true,
+				ForceNew:    true,
+			},
+
+			"memory": {
+				Type:        schema.TypeInt,
+				Description: "Memory in megabytes (**aligned to 128MB**) for the function version.",
+				Required:    true,
+				ForceNew:    true,
+			},
+
+			"description": {
+				Type:        schema.TypeString,
+				Description: common.ResourceDescriptions["description"],
+				Optional:    true,
+				ForceNew:    true,
+			},
+
+			"execution_timeout": {
+				Type:        schema.TypeString,
+				Description: "Execution timeout in seconds for the function version.",
+				Optional:    true,
+				Computed:    true,
+				ForceNew:    true,
+			},
+
+			"service_account_id": {
+				Type:        schema.TypeString,
+				Description: common.ResourceDescriptions["service_account_id"],
+				Optional:    true,
+				ForceNew:    true,
+			},
+
+			"environment": {
+				Type:        schema.TypeMap,
+				Description: "A set of key/value environment variables for the function version. Each key must begin with a letter (A-Z, a-z).",
+				Optional:    true,
+				ForceNew:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Set:         schema.HashString,
+			},
+
+			"concurrency": {
+				Type:         schema.TypeInt,
+				Description:  resourceYandexFunction().Schema["concurrency"].Description,
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.IntBetween(0, 16),
+			},
+
+			"tags": {
+				Type:        schema.TypeSet,
+				Description: "Tags for the function version. Tag `$latest` isn't returned. Tags can be moved between versions without recreating them.",
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Set:         schema.HashString,
+			},
+
+			"package": {
+				Type:         schema.TypeList,
+				Description:  "Deployment package for the function version code. Exactly one of `package` or `content` must be specified.",
+				MaxItems:     1,
+				Optional:     true,
+				ForceNew:     true,
+				ExactlyOneOf: []string{"package", "content"},
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"bucket_name": {
+							Type:        schema.TypeString,
+							Description: "Name of the bucket that stores the code for the version.",
+							Required:    true,
+							ForceNew:    true,
+						},
+						"object_name": {
+							Type:        schema.TypeString,
+							Description: "Name of the object in the bucket that stores the code for the version.",
+							Required:    true,
+							ForceNew:    true,
+						},
+						"sha_256": {
+							Type:        schema.TypeString,
+							Description: "SHA256 hash of the version deployment package.",
+							Optional:    true,
+							ForceNew:    true,
+						},
+					},
+				},
+			},
+
+			"content": {
+				Type:         schema.TypeList,
+				Description:  "Deployment content for the function version code. Exactly one of `package` or `content` must be specified.",
+				MaxItems:     1,
+				Optional:     true,
+				ForceNew:     true,
+				ExactlyOneOf: []string{"package", "content"},
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"zip_filename": {
+							Type:        schema.TypeString,
+							Description: "Filename to zip archive for the version.",
+							Required:    true,
+							ForceNew:    true,
+						},
+					},
+				},
+			},
+
+			"image_size": {
+				Type:        schema.TypeInt,
+				Description: "Image size of the function version.",
+				Computed:    true,
+			},
+
+			"image_sha256": {
+				Type:        schema.TypeString,
+				Description: "SHA256 hash of the deployment package of the function version.",
+				Computed:    true,
+			},
+
+			"created_at": {
+				Type:        schema.TypeString,
+				Description: common.ResourceDescriptions["created_at"],
+				Computed:    true,
+			},
+		},
+	}
+}
+
+func resourceYandexFunctionVersionCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*Config)
+
+	ctx, cancel := context.WithTimeout(config.ContextWithClientTraceID(ctx), d.Timeout(schema.TimeoutCreate))
+	defer cancel()
+
+	req, err := expandFunctionVersion(d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	op, err := config.sdk.WrapOperation(config.sdk.Serverless().Functions().Function().CreateVersion(ctx, req))
+	if err != nil {
+		return diag.Errorf("Error while requesting API to create version for Yandex Cloud Function %q: %s", req.FunctionId, err)
+	}
+
+	protoMetadata, err := op.Metadata()
+	if err != nil {
+		return diag.Errorf("Error while requesting API to create version for Yandex Cloud Function %q: %s", req.FunctionId, err)
+	}
+
+	md, ok := protoMetadata.(*functions.CreateFunctionVersionMetadata)
+	if !ok {
+		return diag.Errorf("Could not get Yandex Cloud Function version ID from create version operation metadata")
+	}
+
+	d.SetId(md.FunctionVersionId)
+
+	if err := op.Wait(ctx); err != nil {
+		return diag.Errorf("Error while requesting API to create version for Yandex Cloud Function %q: %s", req.FunctionId, err)
+	}
+
+	// API does not report hash of the deployment package, so it is kept in state as it was sent on version creation.
+	d.Set("image_sha256", functionVersionPackageSha256(req))
+
+	return resourceYandexFunctionVersionRead(ctx, d, meta)
+}
+
+func resourceYandexFunctionVersionRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*Config)
+
+	ctx, cancel := context.WithTimeout(config.ContextWithClientTraceID(ctx), d.Timeout(schema.TimeoutRead))
+	defer cancel()
+
+	version, err := config.sdk.Serverless().Functions().Function().GetVersion(ctx, &functions.GetFunctionVersionRequest{
+		FunctionVersionId: d.Id(),
+	})
+	if err != nil {
+		return diag.FromErr(handleNotFoundError(err, d, fmt.Sprintf("Yandex Cloud Function version %q", d.Id())))
+	}
+
+	return diag.FromErr(flattenYandexFunctionVersion(d, version))
+}
+
+func resourceYandexFunctionVersionUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*Config)
+
+	ctx, cancel := context.WithTimeout(config.ContextWithClientTraceID(ctx), d.Timeout(schema.TimeoutUpdate))
+	defer cancel()
+
+	if d.HasChange("tags") {
+		o, n := d.GetChange("tags")
+		oldTags, newTags := o.(*schema.Set), n.(*schema.Set)
+
+		for _, t := range oldTags.Difference(newTags).List() {
+			op, err := config.sdk.Serverless().Functions().Function().RemoveTag(ctx, &functions.RemoveFunctionTagRequest{
+				FunctionVersionId: d.Id(),
+				Tag:               t.(string),
+			})
+			if err = waitOperation(ctx, config, op, err); err != nil {
+				return diag.Errorf("Error while requesting API to remove tag %q from Yandex Cloud Function version %q: %s", t, d.Id(), err)
+			}
+		}
+
+		for _, t := range newTags.Difference(oldTags).List() {
+			op, err := config.sdk.Serverless().Functions().Function().SetTag(ctx, &functions.SetFunctionTagRequest{
+				FunctionVersionId: d.Id(),
+				Tag:               t.(string),
+			})
+			if err = waitOperation(ctx, config, op, err); err != nil {
+				return diag.Errorf("Error while requesting API to set tag %q on Yandex Cloud Function version %q: %s", t, d.Id(), err)
+			}
+		}
+	}
+
+	return resourceYandexFunctionVersionRead(ctx, d, meta)
+}
+
+func resourceYandexFunctionVersionDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*Config)
+
+	ctx, cancel := context.WithTimeout(config.ContextWithClientTraceID(ctx), d.Timeout(schema.TimeoutDelete))
+	defer cancel()
+
+	req := functions.DeleteFunctionVersionRequest{
+		FunctionVersionId: d.Id(),
+		Force:             true,
+	}
+
+	op, err := config.sdk.Serverless().Functions().Function().DeleteVersion(ctx, &req)
+	err = waitOperation(ctx, config, op, err)
+	if err != nil {
+		return diag.FromErr(handleNotFoundError(err, d, fmt.Sprintf("Yandex Cloud Function version %q", d.Id())))
+	}
+
+	return nil
+}
+
+func expandFunctionVersion(d *schema.ResourceData) (*functions.CreateFunctionVersionRequest, error) {
+	versionReq := &functions.CreateFunctionVersionRequest{
+		FunctionId:       d.Get("function_id").(string),
+		Runtime:          d.Get("runtime").(string),
+		Entrypoint:       d.Get("entrypoint").(string),
+		Description:      d.Get("description").(string),
+		ServiceAccountId: d.Get("service_account_id").(string),
+		Resources:        &functions.Resources{Memory: int64(datasize.MB.Bytes()) * int64(d.Get("memory").(int))},
+	}
+
+	if v, ok := d.GetOk("execution_timeout"); ok {
+		i, err := strconv.ParseInt(v.(string), 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("Cannot define execution_timeout for Yandex Cloud Function version: %s", err)
+		}
+		versionReq.ExecutionTimeout = &duration.Duration{Seconds: i}
+	}
+	if v, ok := d.GetOk("concurrency"); ok {
+		versionReq.Concurrency = int64(v.(int))
+	}
+	if v, ok := d.GetOk("environment"); ok {
+		env, err := expandLabels(v)
+		if err != nil {
+			return nil, fmt.Errorf("Cannot define environment variables for Yandex Cloud Function version: %s", err)
+		}
+		if len(env) != 0 {
+			versionReq.Environment = env
+		}
+	}
+	if v, ok := d.GetOk("tags"); ok {
+		for _, t := range v.(*schema.Set).List() {
+			versionReq.Tag = append(versionReq.Tag, t.(string))
+		}
+	}
+
+	if err := expandFunctionVersionPackageSource(d, versionReq); err != nil {
+		return nil, err
+	}
+
+	return versionReq, nil
+}
+
+func flattenYandexFunctionVersion(d *schema.ResourceData, version *functions.Version) error {
+	d.Set("function_id", version.FunctionId)
+	d.Set("description", version.Description)
+	d.Set("created_at", getTimestamp(version.CreatedAt))
+	d.Set("image_size", version.ImageSize)
+	d.Set("runtime", version.Runtime)
+	d.Set("entrypoint", version.Entrypoint)
+	d.Set("service_account_id", version.ServiceAccountId)
+	d.Set("concurrency", version.Concurrency)
+	if err := d.Set("environment", version.Environment); err != nil {
+		return err
+	}
+
+	if version.Resources != nil {
+		d.Set("memory", int(version.Resources.Memory/int64(datasize.MB.Bytes())))
+	}
+	if version.ExecutionTimeout != nil && version.ExecutionTimeout.Seconds != 0 {
+		d.Set("execution_timeout", strconv.FormatInt(version.ExecutionTimeout.Seconds, 10))
+	}
+
+	tags := &schema.Set{F: schema.HashString}
+	for _, v := range version.Tags {
+		if v != "$latest" {
+			tags.Add(v)
+		}
+	}
+	return d.Set("tags", tags)
+}
@@ -0,0 +1,147 @@
+package yandex
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/yandex-cloud/go-genproto/yandex/cloud/serverless/functions/v1"
+)
+
+func TestAccYandexFunctionVersion_basic(t *testing.T) {
+	t.Parallel()
+
+	var function functions.Function
+	var blueID, greenID string
+	functionName := acctest.RandomWithPrefix("tf-function")
+	zipFilename := "test-fixtures/serverless/main.zip"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProviderFactoriesV6,
+		CheckDestroy: resource.ComposeTestCheckFunc(
+			testYandexFunctionDestroy,
+			testYandexFunctionVersionDestroy,
+		),
+		Steps: []resource.TestStep{
+			{
+				Config: testYandexFunctionVersionBlueGreen(functionName, zipFilename, "blue", "green"),
+				Check: resource.ComposeTestCheckFunc(
+					testYandexFunctionExists("yandex_function.test-function", &function),
+					testYandexFunctionVersionResourceExists("yandex_function_version.blue", &blueID),
+					testYandexFunctionVersionResourceExists("yandex_function_version.green", &greenID),
+					resource.TestCheckResourceAttrPair("yandex_function_version.blue", "function_id", "yandex_function.test-function", "id"),
+					resource.TestCheckResourceAttr("yandex_function_version.blue", "runtime", "python37"),
+					resource.TestCheckResourceAttr("yandex_function_version.blue", "memory", "128"),
+					resource.TestCheckResourceAttr("yandex_function_version.green", "concurrency", "2"),
+					resource.TestCheckTypeSetElemAttr("yandex_function_version.blue", "tags.*", "blue"),
+					resource.TestCheckTypeSetElemAttr("yandex_function_version.green", "tags.*", "green"),
+					resource.TestCheckResourceAttrSet("yandex_function_version.green", "image_size"),
+					testAccCheckCreatedAtAttr("yandex_function_version.green"),
+				),
+			},
+			{
+				ResourceName:            "yandex_function_version.blue",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"content", "image_sha256"},
+			},
+			{
+				// changing tags must not recreate the version
+				Config: testYandexFunctionVersionBlueGreen(functionName, zipFilename, "stable", "green"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPtr("yandex_function_version.blue", "id", &blueID),
+					resource.TestCheckResourceAttrPtr("yandex_function_version.green", "id", &greenID),
+					resource.TestCheckResourceAttr("yandex_function_version.blue", "tags.#", "1"),
+					resource.TestCheckTypeSetElemAttr("yandex_function_version.blue", "tags.*", "stable"),
+				),
+			},
+		},
+	})
+}
+
+func testYandexFunctionVersionDestroy(s *terraform.State) error {
+	config := testAccProvider.Meta().(*Config)
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "yandex_function_version" {
+			continue
+		}
+
+		_, err := testGetFunctionVersionByID(config, rs.Primary.ID)
+		if err == nil {
+			return fmt.Errorf("Function version still exists")
+		}
+	}
+
+	return nil
+}
+
+func testYandexFunctionVersionResourceExists(name string, versionID *string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[name]
+		if !ok {
+			return fmt.Errorf("Not found: %s", name)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No ID is set")
+		}
+
+		config := testAccProvider.Meta().(*Config)
+
+		found, err := testGetFunctionVersionByID(config, rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		if found.Id != rs.Primary.ID {
+			return fmt.Errorf("Function version not found")
+		}
+
+		*versionID = found.Id
+		return nil
+	}
+}
+
+func testYandexFunctionVersionBlueGreen(functionName, zipFilename, blueTag, greenTag string) string {
+	return fmt.Sprintf(`
+resource "yandex_function" "test-function" {
+  name       = "%[1]s"
+  user_hash  = "user_hash"
+  runtime    = "python37"
+  entrypoint = "main"
+  memory     = "128"
+  content {
+    zip_filename = "%[2]s"
+  }
+}
+
+resource "yandex_function_version" "blue" {
+  function_id = yandex_function.test-function.id
+  runtime     = "python37"
+  entrypoint  = "main"
+  memory      = 128
+  tags        = ["%[3]s"]
+  content {
+    zip_filename = "%[2]s"
+  }
+}
+
+resource "yandex_function_version" "green" {
+  function_id = yandex_function.test-function.id
+  runtime     = "python37"
+  entrypoint  = "main"
+  memory      = 128
+  concurrency = 2
+  tags        = ["%[4]s"]
+  content {
+    zip_filename = "%[2]s"
+  }
+
+  depends_on = [yandex_function_version.blue]
+}
+`, functionName, zipFilename, blueTag, greenTag)
+}
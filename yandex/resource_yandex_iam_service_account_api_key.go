@@ -4,8 +4,11 @@ import (
 	"context"
 	"fmt"
 	"log"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/yandex-cloud/terraform-provider-yandex/common"
 	"github.com/yandex-cloud/terraform-provider-yandex/yandex/internal/encryption"
 	"google.golang.org/genproto/protobuf/field_mask"
@@ -21,6 +24,11 @@ func resourceYandexIAMServiceAccountAPIKey() *schema.Resource {
 		Update:      resourceYandexIAMServiceAccountAPIKeyUpdate,
 		Delete:      resourceYandexIAMServiceAccountAPIKeyDelete,
 
+		CustomizeDiff: customdiff.All(
+			customdiff.ForceNewIfChange("expires_at", isAPIKeyExpired),
+			resourceYandexIAMServiceAccountAPIKeyValidateExpiresAt,
+		),
+
 		Schema: ExtendWithOutputToLockbox(map[string]*schema.Schema{
 			"service_account_id": {
 				Type:        schema.TypeString,
@@ -53,9 +61,11 @@ func resourceYandexIAMServiceAccountAPIKey() *schema.Resource {
 			},
 
 			"expires_at": {
-				Type:        schema.TypeString,
-				Description: "The key will be no longer valid after expiration timestamp.",
-				Optional:    true,
+				Type:         schema.TypeString,
+				Description:  "The key will be no longer valid after expiration timestamp in RFC3339 format. Changing the expiration timestamp of an already expired key forces creation of a new key.",
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.IsRFC3339Time,
 			},
 
 			"pgp_key": {
@@ -273,3 +283,35 @@ func resourceYandexIAMServiceAccountAPIKeyDelete(d *schema.ResourceData, meta in
 	d.SetId("")
 	return nil
 }
+
+// isAPIKeyExpired reports whether the previously stored expiration timestamp has already passed.
+// An expired key can't be used anymore, so it is replaced instead of being updated in place.
+func isAPIKeyExpired(ctx context.Context, old, new, _ interface{}) bool {
+	if old == nil || old.(string) == "" {
+		return false
+	}
+	expiresAt, err := time.Parse(time.RFC3339, old.(string))
+	if err != nil {
+		return false
+	}
+	return expiresAt.Before(time.Now())
+}
+
+// resourceYandexIAMServiceAccountAPIKeyValidateExpiresAt requires the expiration timestamp to be in the future
+// only when it is set for a new key or changed, so that keys which have already expired can still be planned.
+func resourceYandexIAMServiceAccountAPIKeyValidateExpiresAt(_ context.Context, diff *schema.ResourceDiff, _ interface{}) error {
+	if diff.Id() != "" && !diff.HasChange("expires_at") {
+		return nil
+	}
+	if !diff.NewValueKnown("expires_at") {
+		return nil
+	}
+	v, ok := diff.GetOk("expires_at")
+	if !ok {
+		return nil
+	}
+	if _, errs := validateFutureRFC3339Timestamp(v, "expires_at"); len(errs) > 0 {
+		return errs[0]
+	}
+	return nil
+}
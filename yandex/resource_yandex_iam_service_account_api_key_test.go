@@ -4,7 +4,9 @@ import (
 	"context"
 	"fmt"
 	"testing"
+	"time"
 
+	terraform2 "github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
@@ -120,6 +122,136 @@ func TestAccServiceAccountAPIKey_expired(t *testing.T) {
 	})
 }
 
+// Test that changing expiration of an already expired key forces its replacement
+func TestAccServiceAccountAPIKey_expiredReplaced(t *testing.T) {
+	t.Parallel()
+
+	resourceName := "yandex_iam_service_account_api_key.acceptance"
+	accountName := "sa" + acctest.RandString(10)
+	accountDesc := "Terraform Test"
+	expiresAt := time.Now().Add(2 * time.Minute).UTC().Format(time.RFC3339)
+	var keyID string
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckServiceAccountAPIKeyDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccServiceAccountAPIKeyConfigExpiresAt(accountName, accountDesc, expiresAt),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckServiceAccountAPIKeyExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "expires_at", expiresAt),
+					testAccCheckServiceAccountAPIKeyStoreID(resourceName, &keyID),
+				),
+			},
+			{
+				// An expired key with unchanged configuration must still be planned without changes
+				PreConfig: func() {
+					expiresAtTime, _ := time.Parse(time.RFC3339, expiresAt)
+					time.Sleep(time.Until(expiresAtTime.Add(10 * time.Second)))
+				},
+				Config:   testAccServiceAccountAPIKeyConfigExpiresAt(accountName, accountDesc, expiresAt),
+				PlanOnly: true,
+			},
+			{
+				Config: testAccServiceAccountAPIKeyConfigExpiresAt(accountName, accountDesc, "2099-11-11T22:33:44Z"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckServiceAccountAPIKeyExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "expires_at", "2099-11-11T22:33:44Z"),
+					resource.TestCheckResourceAttrSet(resourceName, "secret_key"),
+					testAccCheckServiceAccountAPIKeyRecreated(resourceName, &keyID),
+				),
+			},
+		},
+	})
+}
+
+func TestServiceAccountAPIKeyExpiresAtDiff(t *testing.T) {
+	t.Parallel()
+
+	past := time.Now().Add(-time.Hour).UTC().Format(time.RFC3339)
+	future := time.Now().Add(time.Hour).UTC().Format(time.RFC3339)
+
+	cases := []struct {
+		name        string
+		state       *terraform2.InstanceState
+		expiresAt   string
+		expectError bool
+		requiresNew bool
+	}{
+		{
+			name:        "new key expiring in the past",
+			expiresAt:   past,
+			expectError: true,
+		},
+		{
+			name:      "new key expiring in the future",
+			expiresAt: future,
+		},
+		{
+			name:      "expired key with unchanged expiration",
+			state:     testServiceAccountAPIKeyInstanceState(past),
+			expiresAt: past,
+		},
+		{
+			name:        "key expiration changed to the past",
+			state:       testServiceAccountAPIKeyInstanceState(future),
+			expiresAt:   past,
+			expectError: true,
+		},
+		{
+			name:        "expired key expiration changed to the future",
+			state:       testServiceAccountAPIKeyInstanceState(past),
+			expiresAt:   future,
+			requiresNew: true,
+		},
+	}
+
+	for _, c := range cases {
+		config := terraform2.NewResourceConfigRaw(map[string]interface{}{
+			"service_account_id": "sa-id",
+			"expires_at":         c.expiresAt,
+		})
+
+		// Expiration in the past is checked on plan, not by the schema validation
+		if diags := resourceYandexIAMServiceAccountAPIKey().Validate(config); diags.HasError() {
+			t.Errorf("%s: unexpected validation error: %v", c.name, diags)
+			continue
+		}
+
+		diff, err := resourceYandexIAMServiceAccountAPIKey().Diff(context.Background(), c.state, config, nil)
+		if c.expectError {
+			if err == nil {
+				t.Errorf("%s: expected error, got none", c.name)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("%s: unexpected error: %s", c.name, err)
+			continue
+		}
+		if c.state != nil && !c.requiresNew && diff != nil && !diff.Empty() {
+			t.Errorf("%s: expected empty diff, got %v", c.name, diff)
+		}
+		if c.requiresNew && (diff == nil || !diff.RequiresNew()) {
+			t.Errorf("%s: expected diff requiring new resource, got %v", c.name, diff)
+		}
+	}
+}
+
+func testServiceAccountAPIKeyInstanceState(expiresAt string) *terraform2.InstanceState {
+	return &terraform2.InstanceState{
+		ID: "key-id",
+		Attributes: map[string]string{
+			"id":                 "key-id",
+			"service_account_id": "sa-id",
+			"expires_at":         expiresAt,
+			"scopes.#":           "1",
+			"scopes.0":           "yc.ydb.topics.manage",
+		},
+	}
+}
+
 func TestAccServiceAccountAPIKey_encrypted(t *testing.T) {
 	t.Parallel()
 
@@ -557,6 +689,48 @@ resource "yandex_iam_service_account_api_key" "acceptance" {
 `, name, desc)
 }
 
+func testAccServiceAccountAPIKeyConfigExpiresAt(name, desc, expiresAt string) string {
+	return fmt.Sprintf(`
+resource "yandex_iam_service_account" "acceptance" {
+  name        = "%s"
+  description = "%s"
+}
+
+resource "yandex_iam_service_account_api_key" "acceptance" {
+  service_account_id = "${yandex_iam_service_account.acceptance.id}"
+  description        = "description for test"
+  expires_at         = "%s"
+}
+`, name, desc, expiresAt)
+}
+
+func testAccCheckServiceAccountAPIKeyStoreID(name string, id *string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[name]
+		if !ok {
+			return fmt.Errorf("not found: %s", name)
+		}
+
+		*id = rs.Primary.ID
+		return nil
+	}
+}
+
+func testAccCheckServiceAccountAPIKeyRecreated(name string, id *string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[name]
+		if !ok {
+			return fmt.Errorf("not found: %s", name)
+		}
+
+		if rs.Primary.ID == *id {
+			return fmt.Errorf("API Key %s is not recreated", *id)
+		}
+
+		return nil
+	}
+}
+
 func testAccServiceAccountAPIKeyConfigEncrypted(name, desc, key string) string {
 	return fmt.Sprintf(`
 resource "yandex_iam_service_account" "acceptance" {
@@ -3,6 +3,7 @@ package yandex
 import (
 	"context"
 	"fmt"
+	"time"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/yandex-cloud/go-genproto/yandex/cloud/iam/v1"
@@ -19,6 +20,8 @@ func resourceYandexIAMServiceAccountKey() *schema.Resource {
 		Update:      resourceYandexIAMServiceAccountKeyUpdate,
 		Delete:      resourceYandexIAMServiceAccountKeyDelete,
 
+		CustomizeDiff: resourceYandexIAMServiceAccountKeyCustomizeDiff,
+
 		Schema: ExtendWithOutputToLockbox(map[string]*schema.Schema{
 			"service_account_id": {
 				Type:        schema.TypeString,
@@ -88,6 +91,20 @@ func resourceYandexIAMServiceAccountKey() *schema.Resource {
 				Description: common.ResourceDescriptions["created_at"],
 				Computed:    true,
 			},
+
+			"rotation_period": {
+				Type:             schema.TypeString,
+				Description:      "Period after which the key expires, e.g. `720h`. When set, the key is replaced on the next apply once less than half of the period is left before `expires_at`. The time left is checked when the plan is made, so a plan made just before the half of the period runs out may fail to apply and has to be made again.",
+				Optional:         true,
+				ValidateFunc:     validateParsableValue(parseDuration),
+				DiffSuppressFunc: shouldSuppressDiffForTimeDuration,
+			},
+
+			"expires_at": {
+				Type:        schema.TypeString,
+				Description: "The time the key expires according to `rotation_period`. This is only populated when `rotation_period` is supplied.",
+				Computed:    true,
+			},
 		}, resourceYandexIAMServiceAccountKeySensitiveAttrs),
 	}
 }
@@ -172,6 +189,12 @@ func resourceYandexIAMServiceAccountKeyRead(d *schema.ResourceData, meta interfa
 	d.Set("key_algorithm", iam.Key_Algorithm_name[int32(key.KeyAlgorithm)])
 	d.Set("public_key", key.PublicKey)
 
+	expiresAt, err := getServiceAccountKeyExpiresAt(key.CreatedAt.AsTime(), d.Get("rotation_period").(string))
+	if err != nil {
+		return err
+	}
+	d.Set("expires_at", expiresAt)
+
 	return nil
 }
 
@@ -231,3 +254,65 @@ func resourceYandexIAMServiceAccountKeyDelete(d *schema.ResourceData, meta inter
 	d.SetId("")
 	return nil
 }
+
+func resourceYandexIAMServiceAccountKeyCustomizeDiff(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	if d.Id() == "" || !d.NewValueKnown("rotation_period") {
+		return nil
+	}
+
+	createdAt, err := time.Parse(defaultTimeFormat, d.Get("created_at").(string))
+	if err != nil {
+		return nil
+	}
+
+	rotationPeriod := d.Get("rotation_period").(string)
+	expiresAt, err := getServiceAccountKeyExpiresAt(createdAt, rotationPeriod)
+	if err != nil {
+		return err
+	}
+
+	if d.HasChange("rotation_period") {
+		if err := d.SetNew("expires_at", expiresAt); err != nil {
+			return err
+		}
+	}
+
+	if expiresAt == "" {
+		return nil
+	}
+
+	period, err := time.ParseDuration(rotationPeriod)
+	if err != nil {
+		return err
+	}
+	expiresAtTime, err := time.Parse(defaultTimeFormat, expiresAt)
+	if err != nil {
+		return err
+	}
+
+	// The decision depends on the time of planning, so Terraform reports an inconsistent final plan
+	// if the half of the period runs out between plan and apply. It is documented for rotation_period.
+	if !isServiceAccountKeyRotationDue(expiresAtTime, time.Now(), period) {
+		return nil
+	}
+
+	if err := d.SetNewComputed("expires_at"); err != nil {
+		return err
+	}
+	return d.ForceNew("expires_at")
+}
+
+func getServiceAccountKeyExpiresAt(createdAt time.Time, rotationPeriod string) (string, error) {
+	period, err := parseDuration(rotationPeriod)
+	if err != nil || period == nil {
+		return "", err
+	}
+
+	return createdAt.Add(period.AsDuration()).Format(defaultTimeFormat), nil
+}
+
+// isServiceAccountKeyRotationDue reports whether less than half of the rotation period
+// is left before the key expires.
+func isServiceAccountKeyRotationDue(expiresAt, now time.Time, rotationPeriod time.Duration) bool {
+	return expiresAt.Sub(now) < rotationPeriod/2
+}
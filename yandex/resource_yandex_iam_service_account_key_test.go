@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"github.com/yandex-cloud/go-genproto/yandex/cloud/lockbox/v1"
 	"testing"
+	"time"
 
 	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
@@ -47,6 +48,136 @@ func TestAccServiceAccountKey_basic(t *testing.T) {
 	})
 }
 
+func TestAccServiceAccountKey_rotationPeriod(t *testing.T) {
+	t.Parallel()
+
+	resourceName := "yandex_iam_service_account_key.acceptance"
+	accountName := "sa" + acctest.RandString(10)
+	accountDesc := "Terraform Test"
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckServiceAccountKeyDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccServiceAccountKeyConfigRotationPeriod(accountName, accountDesc, "720h"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckServiceAccountKeyExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "rotation_period", "720h"),
+					resource.TestCheckResourceAttrSet(resourceName, "expires_at"),
+				),
+			},
+			{
+				Config: testAccServiceAccountKeyConfigRotationPeriod(accountName, accountDesc, "1s"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckServiceAccountKeyExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "rotation_period", "1s"),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+			{
+				Config: testAccServiceAccountKeyConfig(accountName, accountDesc, ""),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "expires_at", ""),
+				),
+			},
+		},
+	})
+}
+
+func TestServiceAccountKeyRotationDue(t *testing.T) {
+	t.Parallel()
+
+	now := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	period := 30 * 24 * time.Hour
+
+	cases := []struct {
+		name      string
+		expiresAt time.Time
+		expected  bool
+	}{
+		{
+			name:      "fresh key",
+			expiresAt: now.Add(period),
+			expected:  false,
+		},
+		{
+			name:      "more than half of the period left",
+			expiresAt: now.Add(period/2 + time.Minute),
+			expected:  false,
+		},
+		{
+			name:      "exactly half of the period left",
+			expiresAt: now.Add(period / 2),
+			expected:  false,
+		},
+		{
+			name:      "half of the period ran out a second ago",
+			expiresAt: now.Add(period/2 - time.Second),
+			expected:  true,
+		},
+		{
+			name:      "less than half of the period left",
+			expiresAt: now.Add(period/2 - time.Minute),
+			expected:  true,
+		},
+		{
+			name:      "expired key",
+			expiresAt: now.Add(-time.Hour),
+			expected:  true,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			if actual := isServiceAccountKeyRotationDue(tc.expiresAt, now, period); actual != tc.expected {
+				t.Errorf("isServiceAccountKeyRotationDue() = %v, expected %v", actual, tc.expected)
+			}
+		})
+	}
+}
+
+func TestServiceAccountKeyExpiresAt(t *testing.T) {
+	t.Parallel()
+
+	createdAt := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	cases := []struct {
+		name           string
+		rotationPeriod string
+		expected       string
+		expectedError  bool
+	}{
+		{
+			name:           "no rotation period",
+			rotationPeriod: "",
+			expected:       "",
+		},
+		{
+			name:           "rotation period",
+			rotationPeriod: "720h",
+			expected:       "2024-01-31T00:00:00Z",
+		},
+		{
+			name:           "invalid rotation period",
+			rotationPeriod: "30d",
+			expectedError:  true,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			actual, err := getServiceAccountKeyExpiresAt(createdAt, tc.rotationPeriod)
+			if (err != nil) != tc.expectedError {
+				t.Fatalf("getServiceAccountKeyExpiresAt() error = %v, expected error %v", err, tc.expectedError)
+			}
+			if actual != tc.expected {
+				t.Errorf("getServiceAccountKeyExpiresAt() = %q, expected %q", actual, tc.expected)
+			}
+		})
+	}
+}
+
 func TestAccServiceAccountKey_encrypted(t *testing.T) {
 	t.Parallel()
 
@@ -437,6 +568,20 @@ resource "yandex_iam_service_account_key" "acceptance" {
 `, name, desc, keyDesc)
 }
 
+func testAccServiceAccountKeyConfigRotationPeriod(name, desc, rotationPeriod string) string {
+	return fmt.Sprintf(`
+resource "yandex_iam_service_account" "acceptance" {
+  name        = "%s"
+  description = "%s"
+}
+
+resource "yandex_iam_service_account_key" "acceptance" {
+  service_account_id = "${yandex_iam_service_account.acceptance.id}"
+  rotation_period    = "%s"
+}
+`, name, desc, rotationPeriod)
+}
+
 func testAccServiceAccountKeyConfigEncrypted(name, desc, key string) string {
 	return fmt.Sprintf(`
 resource "yandex_iam_service_account" "acceptance" {
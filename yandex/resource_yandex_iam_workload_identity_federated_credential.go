@@ -2,6 +2,7 @@ package yandex
 
 import (
 	"context"
+	"fmt"
 	"log"
 	"time"
 
@@ -39,7 +40,7 @@ func resourceYandexIAMWorkloadIdentityFederatedCredential() *schema.Resource {
 				Type:        schema.TypeString,
 				Required:    true,
 				ForceNew:    true,
-				Description: "Id of the federated credential.",
+				Description: "Id of the workload identity federation which is used for authentication.",
 			},
 
 			"service_account_id": {
@@ -54,13 +55,13 @@ func resourceYandexIAMWorkloadIdentityFederatedCredential() *schema.Resource {
 				Type:        schema.TypeString,
 				Required:    true,
 				ForceNew:    true,
-				Description: "Id of the workload identity federation which is used for authentication.",
+				Description: "Id of the external subject.",
 			},
 
 			"created_at": {
 				Type:        schema.TypeString,
 				Computed:    true,
-				Description: "Id of the external subject.",
+				Description: "Creation timestamp.",
 			},
 		},
 	}
@@ -115,7 +116,7 @@ func resourceYandexIAMWorkloadIdentityFederatedCredentialRead(ctx context.Contex
 	resp, err := config.sdk.Workload().FederatedCredential().Get(ctx, req)
 
 	if err != nil {
-		return diag.FromErr(err)
+		return diag.FromErr(handleNotFoundError(err, d, fmt.Sprintf("WLI federated credential %q", d.Id())))
 	}
 
 	if err := d.Set("service_account_id", resp.GetServiceAccountId()); err != nil {
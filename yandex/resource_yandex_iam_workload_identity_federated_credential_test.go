@@ -32,6 +32,11 @@ func TestAccIAMWorkloadIdentityFederatedCredential(t *testing.T) {
 					resource.TestCheckResourceAttrSet(resourceName, "created_at"),
 				),
 			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
 		},
 	})
 }
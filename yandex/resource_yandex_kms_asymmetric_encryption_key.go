@@ -91,6 +91,12 @@ func resourceYandexKMSAsymmetricEncryptionKey() *schema.Resource {
 				Description: common.ResourceDescriptions["created_at"],
 				Computed:    true,
 			},
+
+			"public_key": {
+				Type:        schema.TypeString,
+				Description: "The public part of the key in PEM format. Available only for active keys.",
+				Computed:    true,
+			},
 		},
 	}
 }
@@ -174,6 +180,16 @@ func resourceYandexKMSAsymmetricEncryptionKeyRead(d *schema.ResourceData, meta i
 	d.Set("status", strings.ToLower(key.Status.String()))
 	d.Set("deletion_protection", key.DeletionProtection)
 
+	if key.Status == kms.AsymmetricEncryptionKey_ACTIVE {
+		publicKey, err := config.sdk.KMSAsymmetricEncryptionCrypto().AsymmetricEncryptionCrypto().GetPublicKey(ctx, &kms.AsymmetricGetPublicKeyRequest{
+			KeyId: d.Id(),
+		})
+		if err != nil {
+			return fmt.Errorf("Error while requesting API to get public key of KMS AsymmetricEncryptionKey %q: %s", d.Id(), err)
+		}
+		d.Set("public_key", publicKey.PublicKey)
+	}
+
 	if err := d.Set("labels", key.Labels); err != nil {
 		return err
 	}
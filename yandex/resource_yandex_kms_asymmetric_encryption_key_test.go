@@ -3,6 +3,7 @@ package yandex
 import (
 	"context"
 	"fmt"
+	"regexp"
 	"testing"
 
 	"github.com/hashicorp/go-multierror"
@@ -48,6 +49,7 @@ func TestAccKMSAsymmetricEncryptionKey_basic(t *testing.T) {
 					testAccCheckCreatedAtAttr("yandex_kms_asymmetric_encryption_key.key-a"),
 					testAccCheckCreatedAtAttr("yandex_kms_asymmetric_encryption_key.key-b"),
 					testAccCheckCreatedAtAttr("yandex_kms_asymmetric_encryption_key.key-c"),
+					resource.TestMatchResourceAttr("yandex_kms_asymmetric_encryption_key.key-a", "public_key", regexp.MustCompile("^-----BEGIN PUBLIC KEY-----")),
 				),
 			},
 			{
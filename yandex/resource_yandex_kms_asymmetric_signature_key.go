@@ -91,6 +91,12 @@ func resourceYandexKMSAsymmetricSignatureKey() *schema.Resource {
 				Description: common.ResourceDescriptions["created_at"],
 				Computed:    true,
 			},
+
+			"public_key": {
+				Type:        schema.TypeString,
+				Description: "The public part of the key in PEM format. Available only for active keys.",
+				Computed:    true,
+			},
 		},
 	}
 }
@@ -174,6 +180,16 @@ func resourceYandexKMSAsymmetricSignatureKeyRead(d *schema.ResourceData, meta in
 	d.Set("status", strings.ToLower(key.Status.String()))
 	d.Set("deletion_protection", key.DeletionProtection)
 
+	if key.Status == kms.AsymmetricSignatureKey_ACTIVE {
+		publicKey, err := config.sdk.KMSAsymmetricSignatureCrypto().AsymmetricSignatureCrypto().GetPublicKey(ctx, &kms.AsymmetricGetPublicKeyRequest{
+			KeyId: d.Id(),
+		})
+		if err != nil {
+			return fmt.Errorf("Error while requesting API to get public key of KMS AsymmetricSignatureKey %q: %s", d.Id(), err)
+		}
+		d.Set("public_key", publicKey.PublicKey)
+	}
+
 	if err := d.Set("labels", key.Labels); err != nil {
 		return err
 	}
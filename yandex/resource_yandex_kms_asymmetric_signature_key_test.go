@@ -3,6 +3,7 @@ package yandex
 import (
 	"context"
 	"fmt"
+	"regexp"
 	"testing"
 
 	"github.com/hashicorp/go-multierror"
@@ -48,6 +49,8 @@ func TestAccKMSAsymmetricSignatureKey_basic(t *testing.T) {
 					testAccCheckCreatedAtAttr("yandex_kms_asymmetric_signature_key.key-a"),
 					testAccCheckCreatedAtAttr("yandex_kms_asymmetric_signature_key.key-b"),
 					testAccCheckCreatedAtAttr("yandex_kms_asymmetric_signature_key.key-c"),
+					resource.TestMatchResourceAttr("yandex_kms_asymmetric_signature_key.key-a", "public_key", regexp.MustCompile("^-----BEGIN PUBLIC KEY-----")),
+					testAccCheckKMSAsymmetricSignatureKeyCanSign("yandex_kms_asymmetric_signature_key.key-a"),
 				),
 			},
 			{
@@ -267,6 +270,31 @@ func testAccCheckKMSAsymmetricSignatureKeyExists(name string, asymmetricSignatur
 	}
 }
 
+func testAccCheckKMSAsymmetricSignatureKeyCanSign(name string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[name]
+		if !ok {
+			return fmt.Errorf("Not found: %s", name)
+		}
+
+		config := testAccProvider.Meta().(*Config)
+
+		resp, err := config.sdk.KMSAsymmetricSignatureCrypto().AsymmetricSignatureCrypto().Sign(context.Background(), &kms.AsymmetricSignRequest{
+			KeyId:   rs.Primary.ID,
+			Message: []byte("terraform acceptance test"),
+		})
+		if err != nil {
+			return err
+		}
+
+		if len(resp.Signature) == 0 {
+			return fmt.Errorf("KMS AsymmetricSignatureKey %s returned empty signature", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
 func testAccCheckKMSAsymmetricSignatureKeyContainsLabel(asymmetricSignatureKey *kms.AsymmetricSignatureKey, key string, value string) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		v, ok := asymmetricSignatureKey.Labels[key]
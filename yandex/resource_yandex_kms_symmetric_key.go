@@ -83,9 +83,9 @@ func resourceYandexKMSSymmetricKey() *schema.Resource {
 
 			"rotation_period": {
 				Type:             schema.TypeString,
-				Description:      "Interval between automatic rotations. To disable automatic rotation, omit this parameter.",
+				Description:      "Interval between automatic rotations. Must be at least 24 hours. To disable automatic rotation, omit this parameter.",
 				Optional:         true,
-				ValidateFunc:     validateParsableValue(parsePositiveDuration),
+				ValidateFunc:     validateParsableValue(parseKmsRotationPeriod),
 				DiffSuppressFunc: shouldSuppressDiffForTimeDuration,
 			},
 
@@ -208,19 +208,44 @@ func resourceYandexKMSSymmetricKeyRead(d *schema.ResourceData, meta interface{})
 func resourceYandexKMSSymmetricKeyUpdate(d *schema.ResourceData, meta interface{}) error {
 	config := meta.(*Config)
 
+	d.Partial(true)
+
+	req, err := prepareKMSSymmetricKeyUpdateRequest(d)
+	if err != nil {
+		return err
+	}
+
+	//TODO support update Status
+	ctx, cancel := config.ContextWithTimeout(d.Timeout(schema.TimeoutUpdate))
+	defer cancel()
+
+	op, err := config.sdk.WrapOperation(config.sdk.KMS().SymmetricKey().Update(ctx, req))
+	if err != nil {
+		return fmt.Errorf("Error while requesting API to update KMS Symmetric Key %q: %s", d.Id(), err)
+	}
+
+	err = op.Wait(ctx)
+	if err != nil {
+		return fmt.Errorf("Error updating KMS Symmetric Key %q: %s", d.Id(), err)
+	}
+
+	d.Partial(false)
+
+	return resourceYandexKMSSymmetricKeyRead(d, meta)
+}
+
+func prepareKMSSymmetricKeyUpdateRequest(d *schema.ResourceData) (*kms.UpdateSymmetricKeyRequest, error) {
 	var err error
 	req := &kms.UpdateSymmetricKeyRequest{
 		KeyId:      d.Id(),
 		UpdateMask: &field_mask.FieldMask{},
 	}
 
-	d.Partial(true)
-
 	labelPropName := "labels"
 	if d.HasChange(labelPropName) {
 		labelsProp, err := expandLabels(d.Get(labelPropName))
 		if err != nil {
-			return err
+			return nil, err
 		}
 
 		req.Labels = labelsProp
@@ -243,7 +268,7 @@ func resourceYandexKMSSymmetricKeyUpdate(d *schema.ResourceData, meta interface{
 	if d.HasChange(defAlgoName) {
 		defaultAlgorithm, err := parseKmsDefaultAlgorithm(d.Get(defAlgoName).(string))
 		if err != nil {
-			return err
+			return nil, err
 		}
 		req.DefaultAlgorithm = defaultAlgorithm
 		req.UpdateMask.Paths = append(req.UpdateMask.Paths, defAlgoName)
@@ -252,9 +277,9 @@ func resourceYandexKMSSymmetricKeyUpdate(d *schema.ResourceData, meta interface{
 	rotationPeriodName := "rotation_period"
 	if d.HasChange(rotationPeriodName) {
 		req.UpdateMask.Paths = append(req.UpdateMask.Paths, rotationPeriodName)
-		req.RotationPeriod, err = parseDuration(d.Get("rotation_period").(string))
+		req.RotationPeriod, err = parseDuration(d.Get(rotationPeriodName).(string))
 		if err != nil {
-			return err
+			return nil, err
 		}
 	}
 
@@ -264,23 +289,7 @@ func resourceYandexKMSSymmetricKeyUpdate(d *schema.ResourceData, meta interface{
 		req.DeletionProtection = d.Get(deletionProtectionName).(bool)
 	}
 
-	//TODO support update Status
-	ctx, cancel := config.ContextWithTimeout(d.Timeout(schema.TimeoutUpdate))
-	defer cancel()
-
-	op, err := config.sdk.WrapOperation(config.sdk.KMS().SymmetricKey().Update(ctx, req))
-	if err != nil {
-		return fmt.Errorf("Error while requesting API to update KMS Symmetric Key %q: %s", d.Id(), err)
-	}
-
-	err = op.Wait(ctx)
-	if err != nil {
-		return fmt.Errorf("Error updating KMS Symmetric Key %q: %s", d.Id(), err)
-	}
-
-	d.Partial(false)
-
-	return resourceYandexKMSSymmetricKeyRead(d, meta)
+	return req, nil
 }
 
 func resourceYandexKMSSymmetricKeyDelete(d *schema.ResourceData, meta interface{}) error {
@@ -312,3 +321,18 @@ func parsePositiveDuration(s string) (*duration.Duration, error) {
 
 	return d, nil
 }
+
+const kmsMinRotationPeriod = 24 * time.Hour
+
+func parseKmsRotationPeriod(s string) (*duration.Duration, error) {
+	d, err := parsePositiveDuration(s)
+	if err != nil {
+		return nil, err
+	}
+
+	if d.AsDuration() < kmsMinRotationPeriod {
+		return nil, fmt.Errorf("rotation period must be at least %s, got %q", kmsMinRotationPeriod, s)
+	}
+
+	return d, nil
+}
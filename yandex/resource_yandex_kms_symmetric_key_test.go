@@ -5,12 +5,18 @@ import (
 	"errors"
 	"fmt"
 	"testing"
+	"time"
 
 	"github.com/hashicorp/go-multierror"
+	terraform2 "github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 	"github.com/yandex-cloud/go-genproto/yandex/cloud/kms/v1"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/durationpb"
 )
 
 func init() {
@@ -26,6 +32,82 @@ func init() {
 	})
 }
 
+func TestParseKmsRotationPeriod(t *testing.T) {
+	cases := []struct {
+		value   string
+		wantErr bool
+	}{
+		{value: "24h"},
+		{value: "8760h"},
+		{value: "86400s"},
+		{value: "23h59m59s", wantErr: true},
+		{value: "1h", wantErr: true},
+		{value: "0s", wantErr: true},
+		{value: "abc", wantErr: true},
+	}
+
+	for _, tc := range cases {
+		_, err := parseKmsRotationPeriod(tc.value)
+		if tc.wantErr && err == nil {
+			t.Errorf("expected error for rotation period %q", tc.value)
+		}
+		if !tc.wantErr && err != nil {
+			t.Errorf("unexpected error for rotation period %q: %s", tc.value, err)
+		}
+	}
+}
+
+func TestPrepareKMSSymmetricKeyUpdateRequest(t *testing.T) {
+	rawInitial := map[string]interface{}{
+		"name":            "key-a",
+		"description":     "description",
+		"rotation_period": "24h",
+	}
+
+	cases := []struct {
+		name               string
+		diffAttributes     map[string]*terraform2.ResourceAttrDiff
+		wantPaths          []string
+		wantRotationPeriod *durationpb.Duration
+	}{
+		{
+			name: "rotation period changed",
+			diffAttributes: map[string]*terraform2.ResourceAttrDiff{
+				"rotation_period": {Old: "24h", New: "48h"},
+			},
+			wantPaths:          []string{"rotation_period"},
+			wantRotationPeriod: durationpb.New(48 * time.Hour),
+		},
+		{
+			name: "rotation period removed",
+			diffAttributes: map[string]*terraform2.ResourceAttrDiff{
+				"rotation_period": {Old: "24h", New: "", NewRemoved: true},
+			},
+			wantPaths: []string{"rotation_period"},
+		},
+		{
+			name: "rotation period unchanged",
+			diffAttributes: map[string]*terraform2.ResourceAttrDiff{
+				"description": {Old: "description", New: "new description"},
+			},
+			wantPaths: []string{"description"},
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			d := CreateResourceData(t, resourceYandexKMSSymmetricKey().Schema, rawInitial, tc.diffAttributes)
+
+			req, err := prepareKMSSymmetricKeyUpdateRequest(d)
+			require.NoError(t, err)
+
+			assert.ElementsMatch(t, tc.wantPaths, req.GetUpdateMask().GetPaths())
+			assert.True(t, proto.Equal(tc.wantRotationPeriod, req.GetRotationPeriod()),
+				"expected rotation period %v, got %v", tc.wantRotationPeriod, req.GetRotationPeriod())
+		})
+	}
+}
+
 func TestAccKMSSymmetricKey_basic(t *testing.T) {
 	t.Parallel()
 
@@ -473,10 +473,12 @@ func resourceYandexKubernetesCluster() *schema.Resource {
 				Computed:    true,
 			},
 			"network_policy_provider": {
-				Type:        schema.TypeString,
-				Description: "Network policy provider for the cluster. Possible values: `CALICO`.",
-				Optional:    true,
-				ForceNew:    true,
+				Type:             schema.TypeString,
+				Description:      "Network policy provider for the cluster. Possible values: `CALICO`.",
+				Optional:         true,
+				ForceNew:         true,
+				ValidateFunc:     validateKubernetesClusterNetworkPolicyProvider,
+				DiffSuppressFunc: shouldSuppressDiffForNetworkPolicyProvider,
 			},
 			"kms_provider": {
 				Type:        schema.TypeList,
@@ -855,6 +857,18 @@ func getKubernetesClusterNetworkPolicyProviders() string {
 	return strings.Join(values, ",")
 }
 
+func validateKubernetesClusterNetworkPolicyProvider(v interface{}, k string) (warnings []string, errors []error) {
+	prov, ok := k8s.NetworkPolicy_Provider_value[strings.ToUpper(v.(string))]
+	if !ok || prov == int32(k8s.NetworkPolicy_PROVIDER_UNSPECIFIED) {
+		errors = append(errors, fmt.Errorf("invalid %s field value %q, possible values: %v", k, v, getKubernetesClusterNetworkPolicyProviders()))
+	}
+	return
+}
+
+func shouldSuppressDiffForNetworkPolicyProvider(k, old, new string, d *schema.ResourceData) bool {
+	return strings.EqualFold(old, new)
+}
+
 func getKubernetesClusterNetworkPolicy(d *schema.ResourceData) (*k8s.NetworkPolicy, error) {
 	provName, ok := d.GetOk("network_policy_provider")
 	if !ok {
@@ -121,6 +121,26 @@ func updateKubernetesClusterWithSweeperDeps(conf *Config, clusterID, serviceAcco
 	return false
 }
 
+func TestValidateKubernetesClusterNetworkPolicyProvider(t *testing.T) {
+	cases := []struct {
+		value   string
+		isValid bool
+	}{
+		{value: "CALICO", isValid: true},
+		{value: "calico", isValid: true},
+		{value: "PROVIDER_UNSPECIFIED", isValid: false},
+		{value: "cilium", isValid: false},
+		{value: "", isValid: false},
+	}
+
+	for _, tc := range cases {
+		_, errs := validateKubernetesClusterNetworkPolicyProvider(tc.value, "network_policy_provider")
+		if isValid := len(errs) == 0; isValid != tc.isValid {
+			t.Errorf("validation of %q: expected valid %t, got errors %v", tc.value, tc.isValid, errs)
+		}
+	}
+}
+
 //revive:disable:var-naming
 func TestAccKubernetesClusterZonal_basic(t *testing.T) {
 	clusterResource := clusterInfoWithNetworkPolicy("testAccKubernetesClusterZonalConfig_basic", true)
@@ -7,6 +7,7 @@ import (
 	"regexp"
 	"time"
 
+	"google.golang.org/grpc/codes"
 	"google.golang.org/protobuf/encoding/protojson"
 	"google.golang.org/protobuf/types/known/wrapperspb"
 
@@ -78,7 +79,7 @@ func resourceYandexLockboxSecret() *schema.Resource {
 				Description:  "The KMS key used to encrypt the Yandex Cloud Lockbox secret.",
 				Optional:     true,
 				ForceNew:     true,
-				ValidateFunc: validation.StringLenBetween(0, 50),
+				ValidateFunc: validation.StringLenBetween(1, 50),
 			},
 
 			"labels": {
@@ -378,11 +379,17 @@ func resourceYandexLockboxSecretDelete(ctx context.Context, d *schema.ResourceDa
 
 	op, err := config.sdk.WrapOperation(config.sdk.LockboxSecret().Secret().Delete(ctx, req))
 	if err != nil {
+		if isLockboxSecretDeletionProtectionError(d, err) {
+			return diag.FromErr(wrapLockboxSecretDeletionProtectionError(d.Id(), err))
+		}
 		return diag.FromErr(handleNotFoundError(err, d, fmt.Sprintf("secret %q", d.Id())))
 	}
 
 	err = op.Wait(ctx)
 	if err != nil {
+		if isLockboxSecretDeletionProtectionError(d, err) {
+			return diag.FromErr(wrapLockboxSecretDeletionProtectionError(d.Id(), err))
+		}
 		return diag.FromErr(err)
 	}
 
@@ -396,6 +403,17 @@ func resourceYandexLockboxSecretDelete(ctx context.Context, d *schema.ResourceDa
 	return nil
 }
 
+// isLockboxSecretDeletionProtectionError reports whether the secret deletion was refused
+// because of deletion protection, which the API signals only with FailedPrecondition.
+func isLockboxSecretDeletionProtectionError(d *schema.ResourceData, err error) bool {
+	return d.Get("deletion_protection").(bool) && isStatusWithCode(err, codes.FailedPrecondition)
+}
+
+func wrapLockboxSecretDeletionProtectionError(id string, err error) error {
+	return fmt.Errorf("cannot delete Lockbox secret %q, deletion protection is enabled: "+
+		"set deletion_protection = false and apply the change before destroying the secret: %w", id, err)
+}
+
 var resourceYandexLockboxSecretUpdateFieldsMap = map[string]string{
 	"name":                           "name",
 	"description":                    "description",
@@ -2,14 +2,19 @@ package yandex
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"regexp"
 	"testing"
 
 	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
 	"github.com/yandex-cloud/go-genproto/yandex/cloud/lockbox/v1"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 )
 
 func init() {
@@ -88,6 +93,100 @@ func TestAccLockboxSecret_basic(t *testing.T) {
 	})
 }
 
+func TestAccLockboxSecret_deletionProtection(t *testing.T) {
+	secretName := "a" + acctest.RandString(10)
+	basicResource := "yandex_lockbox_secret.basic_secret"
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviderFactories,
+		CheckDestroy:      testAccCheckYandexLockboxSecretAllDestroyed,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccLockboxSecretDeletionProtection(secretName, true),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckYandexLockboxResourceExists(basicResource, nil),
+					resource.TestCheckResourceAttr(basicResource, "deletion_protection", "true"),
+				),
+			},
+			{
+				// Destroy must fail while the secret is protected
+				Config:      testAccLockboxSecretDeletionProtection(secretName, true),
+				Destroy:     true,
+				ExpectError: regexp.MustCompile("deletion protection is enabled"),
+			},
+			{
+				Config: testAccLockboxSecretDeletionProtection(secretName, false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckYandexLockboxResourceExists(basicResource, nil),
+					resource.TestCheckResourceAttr(basicResource, "deletion_protection", "false"),
+				),
+			},
+		},
+	})
+}
+
+func TestLockboxSecretDeletionProtectionError(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		name               string
+		deletionProtection bool
+		err                error
+		expected           bool
+	}{
+		{
+			name:               "failed precondition",
+			deletionProtection: true,
+			err:                status.Error(codes.FailedPrecondition, "operation is not allowed"),
+			expected:           true,
+		},
+		{
+			name:               "wrapped failed precondition",
+			deletionProtection: true,
+			err:                fmt.Errorf("operation failed: %w", status.Error(codes.FailedPrecondition, "operation is not allowed")),
+			expected:           true,
+		},
+		{
+			name:               "failed precondition without deletion protection",
+			deletionProtection: false,
+			err:                status.Error(codes.FailedPrecondition, "operation is not allowed"),
+			expected:           false,
+		},
+		{
+			name:               "deletion protection message",
+			deletionProtection: true,
+			err:                status.Error(codes.InvalidArgument, "Secret has deletion protection enabled"),
+			expected:           false,
+		},
+		{
+			name:               "not found",
+			deletionProtection: true,
+			err:                status.Error(codes.NotFound, "secret not found"),
+			expected:           false,
+		},
+		{
+			name:               "permission denied",
+			deletionProtection: true,
+			err:                status.Error(codes.PermissionDenied, "permission denied"),
+			expected:           false,
+		},
+	}
+
+	for _, c := range cases {
+		d := schema.TestResourceDataRaw(t, resourceYandexLockboxSecret().Schema, map[string]interface{}{
+			"deletion_protection": c.deletionProtection,
+		})
+		if actual := isLockboxSecretDeletionProtectionError(d, c.err); actual != c.expected {
+			t.Errorf("%s: expected %t, got %t", c.name, c.expected, actual)
+		}
+	}
+
+	original := status.Error(codes.FailedPrecondition, "operation is not allowed")
+	if err := wrapLockboxSecretDeletionProtectionError("secret-id", original); !errors.Is(err, original) {
+		t.Errorf("expected original error to be wrapped, got %v", err)
+	}
+}
+
 func TestAccLockboxSecret_kms(t *testing.T) {
 	secretName := "a" + acctest.RandString(10)
 	folderID := getExampleFolderID()
@@ -103,7 +202,7 @@ func TestAccLockboxSecret_kms(t *testing.T) {
 				Config: testAccLockboxSecretWithKmsKey(secretName),
 				Check: resource.ComposeTestCheckFunc(
 					testAccCheckYandexLockboxResourceExists(secretResource, &resourceID), // sets resourceID
-					resource.TestCheckResourceAttrSet(secretResource, "kms_key_id"),
+					resource.TestCheckResourceAttrPair(secretResource, "kms_key_id", "yandex_kms_symmetric_key.some_key1", "id"),
 					resource.TestCheckResourceAttr(secretResource, "folder_id", folderID),
 					resource.TestCheckResourceAttr(secretResource, "deletion_protection", "false"),
 					resource.TestCheckResourceAttr(secretResource, "status",
@@ -119,7 +218,7 @@ func TestAccLockboxSecret_kms(t *testing.T) {
 						return testAccCheckYandexLockboxSecretDestroyed(resourceID)
 					},
 					testAccCheckYandexLockboxResourceExists(secretResource, &resourceID), // checks that now resourceID is different
-					resource.TestCheckResourceAttrSet(secretResource, "kms_key_id"),
+					resource.TestCheckResourceAttrPair(secretResource, "kms_key_id", "yandex_kms_symmetric_key.some_key2", "id"),
 					resource.TestCheckResourceAttr(secretResource, "folder_id", folderID),
 					resource.TestCheckResourceAttr(secretResource, "deletion_protection", "false"),
 					resource.TestCheckResourceAttr(secretResource, "status",
@@ -131,6 +230,24 @@ func TestAccLockboxSecret_kms(t *testing.T) {
 	})
 }
 
+func TestAccLockboxSecret_emptyKmsKeyID(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviderFactories,
+		CheckDestroy:      testAccCheckYandexLockboxSecretAllDestroyed,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+resource "yandex_lockbox_secret" "kms_secret" {
+  kms_key_id = ""
+}
+`,
+				ExpectError: regexp.MustCompile(`expected length of kms_key_id to be in the range \(1 - 50\)`),
+			},
+		},
+	})
+}
+
 func TestAccLockboxSecret_passwordPayloadSpec(t *testing.T) {
 	secretName := "a" + acctest.RandString(10)
 	secretDesc := "Terraform Test With Password Payload Spec"
@@ -212,6 +329,15 @@ resource "yandex_lockbox_secret" "basic_secret" {
 `, name, desc)
 }
 
+func testAccLockboxSecretDeletionProtection(name string, deletionProtection bool) string {
+	return fmt.Sprintf(`
+resource "yandex_lockbox_secret" "basic_secret" {
+  name                = "%v"
+  deletion_protection = %t
+}
+`, name, deletionProtection)
+}
+
 func testAccLockboxSecretMinimal() string {
 	return `
 resource "yandex_lockbox_secret" "minimal_secret" {
@@ -43,7 +43,7 @@ func resourceYandexLockboxSecretVersion() *schema.Resource {
 		Schema: map[string]*schema.Schema{
 			"entries": {
 				Type:        schema.TypeList,
-				Description: "List of entries in the Yandex Cloud Lockbox secret version. Must be omitted for secrets with a payload specification.\n\n~> One either `text_value` or `command` is required.\n",
+				Description: "List of entries in the Yandex Cloud Lockbox secret version. Must be omitted for secrets with a payload specification.\n\n~> One of `text_value`, `binary_value` or `command` is required.\n",
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
 						"key": {
@@ -63,6 +63,15 @@ func resourceYandexLockboxSecretVersion() *schema.Resource {
 							ValidateFunc: validation.StringLenBetween(0, 65536),
 						},
 
+						"binary_value": {
+							Type:         schema.TypeString,
+							Description:  "The binary value of the entry, encoded in base64.",
+							Optional:     true,
+							ForceNew:     true,
+							Sensitive:    true,
+							ValidateFunc: validation.StringIsBase64,
+						},
+
 						"command": {
 							Type:        schema.TypeList,
 							Description: "The command that generates the text value of the entry.",
@@ -1,6 +1,7 @@
 package yandex
 
 import (
+	"bytes"
 	"context"
 	"fmt"
 	"reflect"
@@ -388,7 +389,11 @@ func testAccCheckYandexLockboxVersionEntries(versionResource string, expectedEnt
 			if entry.Key != expectedEntry.Key {
 				return fmt.Errorf("entry at index %d should have key '%s' but has key '%s'", i, expectedEntry.Key, entry.Key)
 			}
-			if expectedEntry.Regexp != nil {
+			if expectedEntry.Binary != nil {
+				if !bytes.Equal(entry.GetBinaryValue(), expectedEntry.Binary) {
+					return fmt.Errorf("entry at index %d should have binary value '%v' but has value '%v'", i, expectedEntry.Binary, entry.GetBinaryValue())
+				}
+			} else if expectedEntry.Regexp != nil {
 				if !expectedEntry.Regexp.MatchString(entry.GetTextValue()) {
 					return fmt.Errorf("entry at index %d should have value that matches '%v' but has value '%s'", i, expectedEntry.Regexp, entry.GetTextValue())
 				}
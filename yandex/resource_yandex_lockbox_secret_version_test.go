@@ -1,8 +1,12 @@
 package yandex
 
 import (
+	"encoding/base64"
 	"fmt"
 	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 )
 
 func TestAccLockboxVersion_basic(t *testing.T) {
@@ -25,6 +29,49 @@ func TestAccLockboxVersion_delete_current_version(t *testing.T) {
 	commonTestAccLockboxVersion_delete_current_version(t, lockboxVersionOriginalOptions)
 }
 
+func TestAccLockboxVersion_binary(t *testing.T) {
+	secretName := "a" + acctest.RandString(10)
+	versionResource := "yandex_lockbox_secret_version.binary_version"
+	binary := []byte{0x00, 0x01, 0xfe, 0xff}
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviderFactories,
+		CheckDestroy:      testAccCheckYandexLockboxSecretAllDestroyed,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccLockboxSecretVersionWithBinary(secretName, base64.StdEncoding.EncodeToString(binary)),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckYandexLockboxResourceExists(versionResource, nil),
+					testAccCheckYandexLockboxVersionEntries(versionResource, []*lockboxEntryCheck{
+						{Key: "k1", Binary: binary},
+						{Key: "k2", Val: "plain value"},
+					}),
+				),
+			},
+		},
+	})
+}
+
+func testAccLockboxSecretVersionWithBinary(name, binaryValue string) string {
+	return fmt.Sprintf(`
+resource "yandex_lockbox_secret" "binary_secret" {
+  name = "%v"
+}
+
+resource "yandex_lockbox_secret_version" "binary_version" {
+  secret_id = yandex_lockbox_secret.binary_secret.id
+  entries {
+    key          = "k1"
+    binary_value = "%v"
+  }
+  entries {
+    key        = "k2"
+    text_value = "plain value"
+  }
+}
+`, name, binaryValue)
+}
+
 var lockboxVersionOriginalOptions = &lockboxVersionOptions{
 	resourceType: "yandex_lockbox_secret_version",
 	entriesToHcl: linesForEntries,
@@ -954,7 +954,7 @@ func resourceYandexMDBMongodbCluster() *schema.Resource {
 						},
 						"time": {
 							Type:         schema.TypeString,
-							Description:  "Timestamp of the moment to which the MongoDB cluster should be restored. (Format: `2006-01-02T15:04:05` - UTC). When not set, current time is used.",
+							Description:  "Timestamp of the moment to which the MongoDB cluster should be restored. (Format: `2006-01-02T15:04:05` - UTC or RFC3339). When not set, current time is used.",
 							Optional:     true,
 							ForceNew:     true,
 							ValidateFunc: stringToTimeValidateFunc,
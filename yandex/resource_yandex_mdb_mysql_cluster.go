@@ -348,7 +348,7 @@ func resourceYandexMDBMySQLCluster() *schema.Resource {
 						},
 						"time": {
 							Type:         schema.TypeString,
-							Description:  "Timestamp of the moment to which the MySQL cluster should be restored. (Format: `2006-01-02T15:04:05` - UTC). When not set, current time is used.",
+							Description:  "Timestamp of the moment to which the MySQL cluster should be restored. (Format: `2006-01-02T15:04:05` - UTC or RFC3339). When not set, current time is used.",
 							Optional:     true,
 							ForceNew:     true,
 							ValidateFunc: stringToTimeValidateFunc,
@@ -393,6 +393,12 @@ func resourceYandexMDBMySQLCluster() *schema.Resource {
 							Optional:    true,
 							Default:     false,
 						},
+						"yandex_query": {
+							Type:        schema.TypeBool,
+							Description: "Allow access for [Yandex Query](https://yandex.cloud/services/query).",
+							Optional:    true,
+							Default:     false,
+						},
 					},
 				},
 			},
@@ -563,12 +569,8 @@ func resourceYandexMDBMySQLClusterCreate(d *schema.ResourceData, meta interface{
 	return resourceYandexMDBMySQLClusterRead(d, meta)
 }
 
-func resourceYandexMDBMySQLClusterRestore(d *schema.ResourceData, meta interface{}, createClusterRequest *mysql.CreateClusterRequest, backupID string) error {
+func resourceYandexMDBMySQLClusterRestore(d *schema.ResourceData, meta interface{}, req *mysql.CreateClusterRequest, backupID string) error {
 	config := meta.(*Config)
-	req, err := prepareCreateMySQLRequest(d, config)
-	if err != nil {
-		return err
-	}
 
 	timeBackup := time.Now()
 
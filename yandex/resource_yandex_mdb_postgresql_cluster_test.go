@@ -988,6 +988,10 @@ func testAccCheckPostgresqlConfigUpdate(r, version string) resource.TestCheckFun
 			return fmt.Errorf("Field 'config.postgresql_config.synchronous_commit' wasn`t changed for with value 5. Current value is %v", userConfig.synchronousCommit)
 		}
 
+		if userConfig.logMinDurationStatement != 1000 {
+			return fmt.Errorf("Field 'config.postgresql_config.log_min_duration_statement' wasn`t changed for with value 1000. Current value is %v", userConfig.logMinDurationStatement)
+		}
+
 		return nil
 	}
 }
@@ -999,6 +1003,7 @@ type clusterSettingsResult struct {
 	defaultTransactionIsolation int32
 	sharedPreloadLibraries      []int32
 	synchronousCommit           int32
+	logMinDurationStatement     int64
 }
 
 func clusterSettings(cluster *postgresql.Cluster, version string) (*clusterSettingsResult, error) {
@@ -1016,6 +1021,7 @@ func clusterSettings(cluster *postgresql.Cluster, version string) (*clusterSetti
 			defaultTransactionIsolation: int32(userConfig.DefaultTransactionIsolation),
 			sharedPreloadLibraries:      sharedPreloadLibraries,
 			synchronousCommit:           int32(userConfig.SynchronousCommit.Number()),
+			logMinDurationStatement:     userConfig.LogMinDurationStatement.GetValue(),
 		}, nil
 	case "13-1c":
 		userConfig := cluster.Config.GetPostgresqlConfig_13_1C().UserConfig
@@ -1030,6 +1036,7 @@ func clusterSettings(cluster *postgresql.Cluster, version string) (*clusterSetti
 			defaultTransactionIsolation: int32(userConfig.DefaultTransactionIsolation),
 			sharedPreloadLibraries:      sharedPreloadLibraries,
 			synchronousCommit:           int32(userConfig.SynchronousCommit.Number()),
+			logMinDurationStatement:     userConfig.LogMinDurationStatement.GetValue(),
 		}, nil
 	case "14":
 		userConfig := cluster.Config.GetPostgresqlConfig_14().UserConfig
@@ -1044,6 +1051,7 @@ func clusterSettings(cluster *postgresql.Cluster, version string) (*clusterSetti
 			defaultTransactionIsolation: int32(userConfig.DefaultTransactionIsolation),
 			sharedPreloadLibraries:      sharedPreloadLibraries,
 			synchronousCommit:           int32(userConfig.SynchronousCommit.Number()),
+			logMinDurationStatement:     userConfig.LogMinDurationStatement.GetValue(),
 		}, nil
 	case "14-1c":
 		userConfig := cluster.Config.GetPostgresqlConfig_14_1C().UserConfig
@@ -1058,6 +1066,7 @@ func clusterSettings(cluster *postgresql.Cluster, version string) (*clusterSetti
 			defaultTransactionIsolation: int32(userConfig.DefaultTransactionIsolation),
 			sharedPreloadLibraries:      sharedPreloadLibraries,
 			synchronousCommit:           int32(userConfig.SynchronousCommit.Number()),
+			logMinDurationStatement:     userConfig.LogMinDurationStatement.GetValue(),
 		}, nil
 	case "15":
 		userConfig := cluster.Config.GetPostgresqlConfig_15().UserConfig
@@ -1072,6 +1081,7 @@ func clusterSettings(cluster *postgresql.Cluster, version string) (*clusterSetti
 			defaultTransactionIsolation: int32(userConfig.DefaultTransactionIsolation),
 			sharedPreloadLibraries:      sharedPreloadLibraries,
 			synchronousCommit:           int32(userConfig.SynchronousCommit.Number()),
+			logMinDurationStatement:     userConfig.LogMinDurationStatement.GetValue(),
 		}, nil
 	case "15-1c":
 		userConfig := cluster.Config.GetPostgresqlConfig_15_1C().UserConfig
@@ -1086,6 +1096,7 @@ func clusterSettings(cluster *postgresql.Cluster, version string) (*clusterSetti
 			defaultTransactionIsolation: int32(userConfig.DefaultTransactionIsolation),
 			sharedPreloadLibraries:      sharedPreloadLibraries,
 			synchronousCommit:           int32(userConfig.SynchronousCommit.Number()),
+			logMinDurationStatement:     userConfig.LogMinDurationStatement.GetValue(),
 		}, nil
 	case "16":
 		userConfig := cluster.Config.GetPostgresqlConfig_16().UserConfig
@@ -1100,6 +1111,7 @@ func clusterSettings(cluster *postgresql.Cluster, version string) (*clusterSetti
 			defaultTransactionIsolation: int32(userConfig.DefaultTransactionIsolation),
 			sharedPreloadLibraries:      sharedPreloadLibraries,
 			synchronousCommit:           int32(userConfig.SynchronousCommit.Number()),
+			logMinDurationStatement:     userConfig.LogMinDurationStatement.GetValue(),
 		}, nil
 	case "16-1c":
 		userConfig := cluster.Config.GetPostgresqlConfig_16_1C().UserConfig
@@ -1114,6 +1126,7 @@ func clusterSettings(cluster *postgresql.Cluster, version string) (*clusterSetti
 			defaultTransactionIsolation: int32(userConfig.DefaultTransactionIsolation),
 			sharedPreloadLibraries:      sharedPreloadLibraries,
 			synchronousCommit:           int32(userConfig.SynchronousCommit.Number()),
+			logMinDurationStatement:     userConfig.LogMinDurationStatement.GetValue(),
 		}, nil
 	case "17":
 		userConfig := cluster.Config.GetPostgresqlConfig_17().UserConfig
@@ -1128,6 +1141,7 @@ func clusterSettings(cluster *postgresql.Cluster, version string) (*clusterSetti
 			defaultTransactionIsolation: int32(userConfig.DefaultTransactionIsolation),
 			sharedPreloadLibraries:      sharedPreloadLibraries,
 			synchronousCommit:           int32(userConfig.SynchronousCommit.Number()),
+			logMinDurationStatement:     userConfig.LogMinDurationStatement.GetValue(),
 		}, nil
 	case "17-1c":
 		userConfig := cluster.Config.GetPostgresqlConfig_17_1C().UserConfig
@@ -1142,6 +1156,7 @@ func clusterSettings(cluster *postgresql.Cluster, version string) (*clusterSetti
 			defaultTransactionIsolation: int32(userConfig.DefaultTransactionIsolation),
 			sharedPreloadLibraries:      sharedPreloadLibraries,
 			synchronousCommit:           int32(userConfig.SynchronousCommit.Number()),
+			logMinDurationStatement:     userConfig.LogMinDurationStatement.GetValue(),
 		}, nil
 	}
 	return nil, fmt.Errorf("Add PostgreSQL %s settings to tests", version)
@@ -1444,6 +1459,7 @@ resource "yandex_mdb_postgresql_cluster" "foo" {
       default_transaction_isolation     = "TRANSACTION_ISOLATION_READ_UNCOMMITTED"
 	  shared_preload_libraries          = "SHARED_PRELOAD_LIBRARIES_AUTO_EXPLAIN,SHARED_PRELOAD_LIBRARIES_PG_HINT_PLAN"
 	  synchronous_commit        		= "SYNCHRONOUS_COMMIT_REMOTE_APPLY"
+      log_min_duration_statement        = 1000
     }
   }
 
@@ -1566,6 +1582,7 @@ resource "yandex_mdb_postgresql_cluster" "foo" {
       default_transaction_isolation     = "TRANSACTION_ISOLATION_READ_UNCOMMITTED"
 	  shared_preload_libraries          = "SHARED_PRELOAD_LIBRARIES_AUTO_EXPLAIN,SHARED_PRELOAD_LIBRARIES_PG_HINT_PLAN"
 	  synchronous_commit        		= "SYNCHRONOUS_COMMIT_REMOTE_APPLY"
+      log_min_duration_statement        = 1000
     }
   }
 
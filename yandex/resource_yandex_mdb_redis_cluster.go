@@ -155,9 +155,10 @@ func resourceYandexMDBRedisCluster() *schema.Resource {
 							Optional:    true,
 						},
 						"repl_backlog_size_percent": {
-							Type:        schema.TypeInt,
-							Description: "Replication backlog size as a percentage of flavor maxmemory.",
-							Optional:    true,
+							Type:         schema.TypeInt,
+							Description:  "Replication backlog size as a percentage of flavor maxmemory.",
+							Optional:     true,
+							ValidateFunc: validation.IntBetween(0, 100),
 						},
 						"cluster_require_full_coverage": {
 							Type:        schema.TypeBool,
@@ -588,6 +588,30 @@ func TestAccMDBRedisCluster_EncryptedDisk(t *testing.T) {
 	})
 }
 
+func TestAccMDBRedisCluster_invalidReplBacklogSizePercent(t *testing.T) {
+	t.Parallel()
+
+	redisName := acctest.RandomWithPrefix("tf-redis-repl-backlog")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckMDBRedisClusterDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccMDBRedisClusterConfigReplBacklogSizePercent(redisName, -1),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile(`expected config.0.repl_backlog_size_percent to be in the range \(0 - 100\), got -1`),
+			},
+			{
+				Config:      testAccMDBRedisClusterConfigReplBacklogSizePercent(redisName, 101),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile(`expected config.0.repl_backlog_size_percent to be in the range \(0 - 100\), got 101`),
+			},
+		},
+	})
+}
+
 func testAccCheckMDBRedisClusterDestroy(s *terraform.State) error {
 	config := testAccProvider.Meta().(*Config)
 
@@ -1554,3 +1578,30 @@ resource "yandex_mdb_redis_cluster" "foo" {
 }
 `, name, desc)
 }
+
+func testAccMDBRedisClusterConfigReplBacklogSizePercent(name string, replBacklogSizePercent int) string {
+	return fmt.Sprintf(redisVPCDependencies+`
+resource "yandex_mdb_redis_cluster" "foo" {
+  name        = "%s"
+  environment = "PRESTABLE"
+  network_id  = yandex_vpc_network.foo.id
+
+  config {
+    password                  = "passw0rd"
+    repl_backlog_size_percent = %d
+    version                   = "7.2"
+  }
+
+  resources {
+    resource_preset_id = "hm3-c2-m8"
+    disk_type_id       = "network-ssd"
+    disk_size          = 16
+  }
+
+  host {
+    zone      = "ru-central1-d"
+    subnet_id = yandex_vpc_subnet.foo.id
+  }
+}
+`, name, replBacklogSizePercent)
+}
@@ -1,6 +1,8 @@
 package yandex
 
 import (
+	"context"
+	"encoding/json"
 	"fmt"
 	"log"
 	"net/url"
@@ -42,6 +44,9 @@ func resourceYandexMessageQueue() *schema.Resource {
 		Read:   resourceYandexMessageQueueRead,
 		Update: resourceYandexMessageQueueUpdate,
 		Delete: resourceYandexMessageQueueDelete,
+
+		CustomizeDiff: resourceYandexMessageQueueCustomizeDiff,
+
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
@@ -99,15 +104,39 @@ func resourceYandexMessageQueue() *schema.Resource {
 				ValidateFunc: validation.IntBetween(0, 43200),
 			},
 			"redrive_policy": {
-				Type:         schema.TypeString,
-				Description:  "Message redrive policy in [Dead Letter Queue](https://yandex.cloud/docs/message-queue/concepts/dlq). The source queue and DLQ must be the same type: for FIFO queues, the DLQ must also be a FIFO queue. For more information about redrive policy see [documentation](https://yandex.cloud/docs/message-queue/api-ref/queue/CreateQueue). Also you can use example in this page.",
-				Optional:     true,
-				ValidateFunc: validation.StringIsJSON,
+				Type:          schema.TypeString,
+				Description:   "Message redrive policy in [Dead Letter Queue](https://yandex.cloud/docs/message-queue/concepts/dlq). The source queue and DLQ must be the same type: for FIFO queues, the DLQ must also be a FIFO queue. For more information about redrive policy see [documentation](https://yandex.cloud/docs/message-queue/api-ref/queue/CreateQueue). Also you can use example in this page.",
+				Optional:      true,
+				ConflictsWith: []string{"dead_letter_queue"},
+				ValidateFunc:  validation.StringIsJSON,
 				StateFunc: func(v interface{}) string {
 					json, _ := structure.NormalizeJsonString(v)
 					return json
 				},
 			},
+			"dead_letter_queue": {
+				Type:          schema.TypeList,
+				Description:   "Message redrive policy in [Dead Letter Queue](https://yandex.cloud/docs/message-queue/concepts/dlq) as a structured block. An alternative to the JSON encoded `redrive_policy`. Conflicts with `redrive_policy`.",
+				Optional:      true,
+				MaxItems:      1,
+				ConflictsWith: []string{"redrive_policy"},
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"dead_letter_queue_arn": {
+							Type:         schema.TypeString,
+							Description:  "ARN of the Dead Letter Queue to move messages to after `max_receive_count` unsuccessful receive attempts.",
+							Required:     true,
+							ValidateFunc: validation.StringIsNotEmpty,
+						},
+						"max_receive_count": {
+							Type:         schema.TypeInt,
+							Description:  "Maximum number of times a message can be received before it is moved to the Dead Letter Queue. Valid values: from 1 to 1000.",
+							Required:     true,
+							ValidateFunc: validation.IntBetween(1, 1000),
+						},
+					},
+				},
+			},
 			"fifo_queue": {
 				Type:        schema.TypeBool,
 				Description: "Is this queue [FIFO](https://yandex.cloud/docs/message-queue/concepts/queue#fifo-queues). If this parameter is not used, a standard queue is created. You cannot change the parameter value for a created queue.",
@@ -181,12 +210,6 @@ func resourceYandexMessageQueueCreate(d *schema.ResourceData, meta interface{})
 		}
 	}
 
-	contentBasedDeduplication := d.Get("content_based_deduplication").(bool)
-
-	if !isFifo && contentBasedDeduplication {
-		return fmt.Errorf("Content based deduplication can only be set with FIFO queues")
-	}
-
 	if isFifo {
 		if errors := validateFifoQueueName(name); len(errors) > 0 {
 			return fmt.Errorf("Error validating the FIFO queue name: %v", errors)
@@ -233,6 +256,14 @@ func resourceYandexMessageQueueCreate(d *schema.ResourceData, meta interface{})
 		}
 	}
 
+	redrivePolicy, err := getMessageQueueRedrivePolicy(d)
+	if err != nil {
+		return err
+	}
+	if redrivePolicy != "" {
+		attributes[sqs.QueueAttributeNameRedrivePolicy] = aws.String(redrivePolicy)
+	}
+
 	if len(attributes) > 0 {
 		req.Attributes = attributes
 	}
@@ -337,6 +368,15 @@ func resourceYandexMessageQueueUpdate(d *schema.ResourceData, meta interface{})
 		}
 	}
 
+	if d.HasChange("redrive_policy") || d.HasChange("dead_letter_queue") {
+		redrivePolicy, err := getMessageQueueRedrivePolicy(d)
+		if err != nil {
+			return err
+		}
+		log.Printf("[DEBUG] Updating %s for queue %s", sqs.QueueAttributeNameRedrivePolicy, d.Id())
+		attributes[sqs.QueueAttributeNameRedrivePolicy] = aws.String(redrivePolicy)
+	}
+
 	if len(attributes) > 0 {
 		log.Printf("[INFO] Setting new messsage queue attributes for queue %s", d.Id())
 
@@ -498,98 +538,179 @@ func resourceYandexMessageQueueReadImpl(d *schema.ResourceData, meta interface{}
 			d.Set("region_id", region)
 		}
 
-		if v, ok := queueAttributes[sqs.QueueAttributeNameContentBasedDeduplication]; ok && v != "" {
-			vBool, err := strconv.ParseBool(v)
+		if err := flattenMessageQueueAttributes(d, queueAttributes); err != nil {
+			return err
+		}
 
+		// Keep the redrive policy in the form it was configured in to avoid
+		// spurious diffs between redrive_policy and dead_letter_queue.
+		if _, ok := d.GetOk("dead_letter_queue"); ok {
+			deadLetterQueue, err := flattenMessageQueueDeadLetterQueue(queueAttributes[sqs.QueueAttributeNameRedrivePolicy])
 			if err != nil {
-				return fmt.Errorf("Error parsing content_based_deduplication value (%s) into boolean: %s", v, err)
+				return err
 			}
-
-			d.Set("content_based_deduplication", vBool)
+			if err := d.Set("dead_letter_queue", deadLetterQueue); err != nil {
+				return err
+			}
+			d.Set("redrive_policy", "")
 		}
+	}
+	return nil
+}
 
-		if v, ok := queueAttributes[sqs.QueueAttributeNameDelaySeconds]; ok && v != "" {
-			vInt, err := strconv.Atoi(v)
+func flattenMessageQueueAttributes(d *schema.ResourceData, queueAttributes map[string]string) error {
+	if v, ok := queueAttributes[sqs.QueueAttributeNameContentBasedDeduplication]; ok && v != "" {
+		vBool, err := strconv.ParseBool(v)
 
-			if err != nil {
-				return fmt.Errorf("Error parsing delay_seconds value (%s) into integer: %s", v, err)
-			}
+		if err != nil {
+			return fmt.Errorf("Error parsing content_based_deduplication value (%s) into boolean: %s", v, err)
+		}
 
-			d.Set("delay_seconds", vInt)
+		if err := d.Set("content_based_deduplication", vBool); err != nil {
+			return err
 		}
+	}
 
-		if v, ok := queueAttributes[sqs.QueueAttributeNameFifoQueue]; ok && v != "" {
-			vBool, err := strconv.ParseBool(v)
+	if v, ok := queueAttributes[sqs.QueueAttributeNameDelaySeconds]; ok && v != "" {
+		vInt, err := strconv.Atoi(v)
 
-			if err != nil {
-				return fmt.Errorf("Error parsing fifo_queue value (%s) into boolean: %s", v, err)
-			}
+		if err != nil {
+			return fmt.Errorf("Error parsing delay_seconds value (%s) into integer: %s", v, err)
+		}
 
-			d.Set("fifo_queue", vBool)
+		if err := d.Set("delay_seconds", vInt); err != nil {
+			return err
 		}
+	}
 
-		if v, ok := queueAttributes[sqs.QueueAttributeNameKmsDataKeyReusePeriodSeconds]; ok && v != "" {
-			vInt, err := strconv.Atoi(v)
+	if v, ok := queueAttributes[sqs.QueueAttributeNameFifoQueue]; ok && v != "" {
+		vBool, err := strconv.ParseBool(v)
 
-			if err != nil {
-				return fmt.Errorf("Error parsing kms_data_key_reuse_period_seconds value (%s) into integer: %s", v, err)
-			}
+		if err != nil {
+			return fmt.Errorf("Error parsing fifo_queue value (%s) into boolean: %s", v, err)
+		}
 
-			d.Set("kms_data_key_reuse_period_seconds", vInt)
+		if err := d.Set("fifo_queue", vBool); err != nil {
+			return err
 		}
+	}
+
+	if v, ok := queueAttributes[sqs.QueueAttributeNameMaximumMessageSize]; ok && v != "" {
+		vInt, err := strconv.Atoi(v)
 
-		if v, ok := queueAttributes[sqs.QueueAttributeNameKmsMasterKeyId]; ok {
-			d.Set("kms_master_key_id", v)
+		if err != nil {
+			return fmt.Errorf("Error parsing max_message_size value (%s) into integer: %s", v, err)
 		}
 
-		if v, ok := queueAttributes[sqs.QueueAttributeNameMaximumMessageSize]; ok && v != "" {
-			vInt, err := strconv.Atoi(v)
+		if err := d.Set("max_message_size", vInt); err != nil {
+			return err
+		}
+	}
 
-			if err != nil {
-				return fmt.Errorf("Error parsing max_message_size value (%s) into integer: %s", v, err)
-			}
+	if v, ok := queueAttributes[sqs.QueueAttributeNameMessageRetentionPeriod]; ok && v != "" {
+		vInt, err := strconv.Atoi(v)
 
-			d.Set("max_message_size", vInt)
+		if err != nil {
+			return fmt.Errorf("Error parsing message_retention_seconds value (%s) into integer: %s", v, err)
 		}
 
-		if v, ok := queueAttributes[sqs.QueueAttributeNameMessageRetentionPeriod]; ok && v != "" {
-			vInt, err := strconv.Atoi(v)
+		if err := d.Set("message_retention_seconds", vInt); err != nil {
+			return err
+		}
+	}
 
-			if err != nil {
-				return fmt.Errorf("Error parsing message_retention_seconds value (%s) into integer: %s", v, err)
-			}
+	if v, ok := queueAttributes[sqs.QueueAttributeNameReceiveMessageWaitTimeSeconds]; ok && v != "" {
+		vInt, err := strconv.Atoi(v)
 
-			d.Set("message_retention_seconds", vInt)
+		if err != nil {
+			return fmt.Errorf("Error parsing receive_wait_time_seconds value (%s) into integer: %s", v, err)
 		}
 
-		if v, ok := queueAttributes[sqs.QueueAttributeNamePolicy]; ok {
-			d.Set("policy", v)
+		if err := d.Set("receive_wait_time_seconds", vInt); err != nil {
+			return err
 		}
+	}
 
-		if v, ok := queueAttributes[sqs.QueueAttributeNameReceiveMessageWaitTimeSeconds]; ok && v != "" {
-			vInt, err := strconv.Atoi(v)
+	if v, ok := queueAttributes[sqs.QueueAttributeNameRedrivePolicy]; ok {
+		if err := d.Set("redrive_policy", v); err != nil {
+			return err
+		}
+	}
 
-			if err != nil {
-				return fmt.Errorf("Error parsing receive_wait_time_seconds value (%s) into integer: %s", v, err)
-			}
+	if v, ok := queueAttributes[sqs.QueueAttributeNameVisibilityTimeout]; ok && v != "" {
+		vInt, err := strconv.Atoi(v)
 
-			d.Set("receive_wait_time_seconds", vInt)
+		if err != nil {
+			return fmt.Errorf("Error parsing visibility_timeout_seconds value (%s) into integer: %s", v, err)
 		}
 
-		if v, ok := queueAttributes[sqs.QueueAttributeNameRedrivePolicy]; ok {
-			d.Set("redrive_policy", v)
+		if err := d.Set("visibility_timeout_seconds", vInt); err != nil {
+			return err
 		}
+	}
 
-		if v, ok := queueAttributes[sqs.QueueAttributeNameVisibilityTimeout]; ok && v != "" {
-			vInt, err := strconv.Atoi(v)
+	return nil
+}
 
-			if err != nil {
-				return fmt.Errorf("Error parsing visibility_timeout_seconds value (%s) into integer: %s", v, err)
-			}
+type messageQueueRedrivePolicy struct {
+	DeadLetterTargetArn string `json:"deadLetterTargetArn"`
+	MaxReceiveCount     int    `json:"maxReceiveCount"`
+}
 
-			d.Set("visibility_timeout_seconds", vInt)
-		}
+// getMessageQueueRedrivePolicy returns the JSON encoded redrive policy either
+// from the dead_letter_queue block or from the raw redrive_policy attribute.
+func getMessageQueueRedrivePolicy(d *schema.ResourceData) (string, error) {
+	deadLetterQueue := d.Get("dead_letter_queue").([]interface{})
+	if len(deadLetterQueue) == 0 || deadLetterQueue[0] == nil {
+		return d.Get("redrive_policy").(string), nil
 	}
+
+	v := deadLetterQueue[0].(map[string]interface{})
+	policy, err := json.Marshal(messageQueueRedrivePolicy{
+		DeadLetterTargetArn: v["dead_letter_queue_arn"].(string),
+		MaxReceiveCount:     v["max_receive_count"].(int),
+	})
+	if err != nil {
+		return "", fmt.Errorf("Error encoding redrive policy: %s", err)
+	}
+
+	return string(policy), nil
+}
+
+func flattenMessageQueueDeadLetterQueue(redrivePolicy string) ([]map[string]interface{}, error) {
+	if redrivePolicy == "" {
+		return nil, nil
+	}
+
+	// maxReceiveCount may come back either as a number or as a string.
+	var policy map[string]interface{}
+	if err := json.Unmarshal([]byte(redrivePolicy), &policy); err != nil {
+		return nil, fmt.Errorf("Error parsing redrive policy (%s): %s", redrivePolicy, err)
+	}
+
+	deadLetterTargetArn, _ := policy["deadLetterTargetArn"].(string)
+	maxReceiveCount, err := strconv.Atoi(fmt.Sprint(policy["maxReceiveCount"]))
+	if err != nil {
+		return nil, fmt.Errorf("Error parsing maxReceiveCount value (%v) of redrive policy into integer: %s", policy["maxReceiveCount"], err)
+	}
+
+	return []map[string]interface{}{
+		{
+			"dead_letter_queue_arn": deadLetterTargetArn,
+			"max_receive_count":     maxReceiveCount,
+		},
+	}, nil
+}
+
+func resourceYandexMessageQueueCustomizeDiff(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	if !d.NewValueKnown("fifo_queue") || !d.NewValueKnown("content_based_deduplication") {
+		return nil
+	}
+
+	if !d.Get("fifo_queue").(bool) && d.Get("content_based_deduplication").(bool) {
+		return fmt.Errorf("Content based deduplication can only be set with FIFO queues")
+	}
+
 	return nil
 }
 
@@ -177,6 +177,64 @@ func TestAccMessageQueue_redrivePolicy(t *testing.T) {
 	})
 }
 
+func TestAccMessageQueue_deadLetterQueue(t *testing.T) {
+	var queueAttributes map[string]*string
+	var redriverQueueAttributes map[string]*string
+
+	var randInt int = acctest.RandInt()
+	resourceName := "yandex_message_queue.queue"
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckMessageQueueDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccMessageQueueConfigWithDeadLetterQueue(randInt, 3),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckMessageQueueExists("yandex_message_queue.dead_letter_queue", &queueAttributes),
+					testAccCheckMessageQueueExists(resourceName, &redriverQueueAttributes),
+					testAccCheckMessageQueueRedriverAttributes(&redriverQueueAttributes, &queueAttributes),
+					resource.TestCheckResourceAttr(resourceName, "dead_letter_queue.#", "1"),
+					resource.TestCheckResourceAttrPair(resourceName, "dead_letter_queue.0.dead_letter_queue_arn", "yandex_message_queue.dead_letter_queue", "arn"),
+					resource.TestCheckResourceAttr(resourceName, "dead_letter_queue.0.max_receive_count", "3"),
+					resource.TestCheckResourceAttr(resourceName, "redrive_policy", ""),
+				),
+			},
+			{
+				Config: testAccMessageQueueConfigWithDeadLetterQueue(randInt, 10),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckMessageQueueExists(resourceName, &redriverQueueAttributes),
+					resource.TestCheckResourceAttr(resourceName, "dead_letter_queue.0.max_receive_count", "10"),
+				),
+			},
+			{
+				Config: testAccMessageQueueConfigWithDeadLetterQueueRemoved(randInt),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckMessageQueueExists(resourceName, &redriverQueueAttributes),
+					resource.TestCheckResourceAttr(resourceName, "dead_letter_queue.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "redrive_policy", ""),
+				),
+			},
+		},
+	})
+}
+
+func TestAccMessageQueue_deadLetterQueueInvalidMaxReceiveCount(t *testing.T) {
+	var randInt int = acctest.RandInt()
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckMessageQueueDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccMessageQueueConfigWithDeadLetterQueue(randInt, 1001),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile(`expected dead_letter_queue.0.max_receive_count to be in the range \(1 - 1000\)`),
+			},
+		},
+	})
+}
+
 func TestAccMessageQueue_FIFO(t *testing.T) {
 	var queueAttributes map[string]*string
 
@@ -235,6 +293,36 @@ func TestAccMessageQueue_FIFOWithContentBasedDeduplication(t *testing.T) {
 	})
 }
 
+func TestAccMessageQueue_FIFOContentBasedDeduplicationUpdate(t *testing.T) {
+	var queueAttributes map[string]*string
+
+	var randInt int = acctest.RandInt()
+	resourceName := "yandex_message_queue.queue"
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckMessageQueueDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccMessageQueueConfigWithFIFOContentBasedDeduplicationFlag(randInt, false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckMessageQueueExists(resourceName, &queueAttributes),
+					resource.TestCheckResourceAttr(resourceName, "fifo_queue", "true"),
+					resource.TestCheckResourceAttr(resourceName, "content_based_deduplication", "false"),
+				),
+			},
+			{
+				Config: testAccMessageQueueConfigWithFIFOContentBasedDeduplicationFlag(randInt, true),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckMessageQueueExists(resourceName, &queueAttributes),
+					resource.TestCheckResourceAttr(resourceName, "fifo_queue", "true"),
+					resource.TestCheckResourceAttr(resourceName, "content_based_deduplication", "true"),
+				),
+			},
+		},
+	})
+}
+
 func TestAccMessageQueue_ExpectContentBasedDeduplicationError(t *testing.T) {
 	var randInt int = acctest.RandInt()
 	resource.Test(t, resource.TestCase{
@@ -244,6 +332,7 @@ func TestAccMessageQueue_ExpectContentBasedDeduplicationError(t *testing.T) {
 		Steps: []resource.TestStep{
 			{
 				Config:      testAccExpectContentBasedDeduplicationError(randInt),
+				PlanOnly:    true,
 				ExpectError: regexp.MustCompile(`Content based deduplication can only be set with FIFO queues`),
 			},
 		},
@@ -716,6 +805,47 @@ resource "yandex_message_queue" "dead_letter_queue" {
 `, randInt, randInt) + testAccCommonIamDependenciesEditorConfig(randInt)
 }
 
+func testAccMessageQueueConfigWithDeadLetterQueue(randInt int, maxReceiveCount int) string {
+	return fmt.Sprintf(`
+resource "yandex_message_queue" "queue" {
+  name = "tftestqueuq-dlq-%[1]d"
+
+  access_key = yandex_iam_service_account_static_access_key.sa-key.access_key
+  secret_key = yandex_iam_service_account_static_access_key.sa-key.secret_key
+
+  dead_letter_queue {
+    dead_letter_queue_arn = yandex_message_queue.dead_letter_queue.arn
+    max_receive_count     = %[2]d
+  }
+}
+
+resource "yandex_message_queue" "dead_letter_queue" {
+  name = "tfotherqueuq-dlq-%[1]d"
+
+  access_key = yandex_iam_service_account_static_access_key.sa-key.access_key
+  secret_key = yandex_iam_service_account_static_access_key.sa-key.secret_key
+}
+`, randInt, maxReceiveCount) + testAccCommonIamDependenciesEditorConfig(randInt)
+}
+
+func testAccMessageQueueConfigWithDeadLetterQueueRemoved(randInt int) string {
+	return fmt.Sprintf(`
+resource "yandex_message_queue" "queue" {
+  name = "tftestqueuq-dlq-%[1]d"
+
+  access_key = yandex_iam_service_account_static_access_key.sa-key.access_key
+  secret_key = yandex_iam_service_account_static_access_key.sa-key.secret_key
+}
+
+resource "yandex_message_queue" "dead_letter_queue" {
+  name = "tfotherqueuq-dlq-%[1]d"
+
+  access_key = yandex_iam_service_account_static_access_key.sa-key.access_key
+  secret_key = yandex_iam_service_account_static_access_key.sa-key.secret_key
+}
+`, randInt) + testAccCommonIamDependenciesEditorConfig(randInt)
+}
+
 func testAccMessageQueueConfigWithFIFO(randInt int) string {
 	return fmt.Sprintf(`
 resource "yandex_message_queue" "queue" {
@@ -741,6 +871,19 @@ resource "yandex_message_queue" "queue" {
 `, randInt) + testAccCommonIamDependenciesEditorConfig(randInt)
 }
 
+func testAccMessageQueueConfigWithFIFOContentBasedDeduplicationFlag(randInt int, contentBasedDeduplication bool) string {
+	return fmt.Sprintf(`
+resource "yandex_message_queue" "queue" {
+  name                        = "message-queue-cbd-update-%d.fifo"
+  fifo_queue                  = true
+  content_based_deduplication = %t
+
+  access_key = yandex_iam_service_account_static_access_key.sa-key.access_key
+  secret_key = yandex_iam_service_account_static_access_key.sa-key.secret_key
+}
+`, randInt, contentBasedDeduplication) + testAccCommonIamDependenciesEditorConfig(randInt)
+}
+
 func testAccMessageQueueConfigWithFIFOExpectError(randInt int) string {
 	return fmt.Sprintf(`
 resource "yandex_message_queue" "queue" {
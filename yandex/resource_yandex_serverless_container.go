@@ -343,9 +343,10 @@ func resourceYandexServerlessContainer() *schema.Resource {
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
 						"min_instances": {
-							Type:        schema.TypeInt,
-							Description: "Minimum number of prepared instances that are always ready to serve requests.",
-							Required:    true,
+							Type:         schema.TypeInt,
+							Description:  "Minimum number of prepared instances that are always ready to serve requests.",
+							Required:     true,
+							ValidateFunc: validation.IntAtLeast(0),
 						},
 					},
 				},
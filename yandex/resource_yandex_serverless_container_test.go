@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"os"
+	"regexp"
 	"strconv"
 	"strings"
 	"testing"
@@ -123,6 +124,37 @@ func TestAccYandexServerlessContainer_update(t *testing.T) {
 	})
 }
 
+func TestAccYandexServerlessContainer_provisionPolicy(t *testing.T) {
+	t.Parallel()
+
+	var container containers.Container
+	var revision containers.Revision
+	containerName := acctest.RandomWithPrefix("tf-container")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProviderFactoriesV6,
+		CheckDestroy:             testYandexServerlessContainerDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testYandexServerlessContainerProvisionPolicy(containerName, 1),
+				Check: resource.ComposeTestCheckFunc(
+					testYandexServerlessContainerExists(serverlessContainerResource, &container),
+					testYandexServerlessContainerRevisionExists(serverlessContainerResource, &revision),
+					resource.TestCheckResourceAttr(serverlessContainerResource, "provision_policy.0.min_instances", "1"),
+					testYandexServerlessContainerRevisionMinInstances(&revision, 1),
+				),
+			},
+			serverlessContainerImportTestStep(),
+			{
+				Config:      testYandexServerlessContainerProvisionPolicy(containerName, -1),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile(`expected provision_policy.0.min_instances to be at least \(0\)`),
+			},
+		},
+	})
+}
+
 func serverlessContainerImportTestStep() resource.TestStep {
 	return resource.TestStep{
 		ResourceName:            serverlessContainerResource,
@@ -805,6 +837,15 @@ func testYandexServerlessContainerRevisionConcurrency(revision *containers.Revis
 	}
 }
 
+func testYandexServerlessContainerRevisionMinInstances(revision *containers.Revision, minInstances int) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		if actual := revision.GetProvisionPolicy().GetMinInstances(); actual != int64(minInstances) {
+			return fmt.Errorf("Incorrect provision policy min instances: expected '%d' but found '%d'", minInstances, actual)
+		}
+		return nil
+	}
+}
+
 func testYandexServerlessContainerRevisionCores(revision *containers.Revision, cores int, coreFraction int) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		if revision.Resources.Cores != int64(cores) {
@@ -953,6 +994,21 @@ resource "yandex_serverless_container" "test-container" {
 	`, name, desc, memory, image)
 }
 
+func testYandexServerlessContainerProvisionPolicy(name string, minInstances int) string {
+	return fmt.Sprintf(`
+resource "yandex_serverless_container" "test-container" {
+  name   = "%s"
+  memory = 128
+  image {
+    url = "%s"
+  }
+  provision_policy {
+    min_instances = %d
+  }
+}
+	`, name, serverlessContainerTestImage1, minInstances)
+}
+
 type testYandexServerlessContainerParameters struct {
 	name                  string
 	desc                  string
@@ -308,18 +308,20 @@ func resourceYandexStorageBucket() *schema.Resource {
 													),
 												},
 												"days": {
-													Type:        schema.TypeInt,
-													Description: "Specifies a retention period in days after uploading an object version. It must be a positive integer. You can't set it simultaneously with `years`.",
-													Optional:    true,
+													Type:         schema.TypeInt,
+													Description:  "Specifies a retention period in days after uploading an object version. It must be a positive integer. You can't set it simultaneously with `years`.",
+													Optional:     true,
+													ValidateFunc: validation.IntAtLeast(1),
 													ExactlyOneOf: []string{
 														"object_lock_configuration.0.rule.0.default_retention.0.days",
 														"object_lock_configuration.0.rule.0.default_retention.0.years",
 													},
 												},
 												"years": {
-													Type:        schema.TypeInt,
-													Description: "Specifies a retention period in years after uploading an object version. It must be a positive integer. You can't set it simultaneously with `days`.",
-													Optional:    true,
+													Type:         schema.TypeInt,
+													Description:  "Specifies a retention period in years after uploading an object version. It must be a positive integer. You can't set it simultaneously with `days`.",
+													Optional:     true,
+													ValidateFunc: validation.IntAtLeast(1),
 													ExactlyOneOf: []string{
 														"object_lock_configuration.0.rule.0.default_retention.0.days",
 														"object_lock_configuration.0.rule.0.default_retention.0.years",
@@ -106,6 +106,33 @@ func TestAccStorageBucket_basic(t *testing.T) {
 	}
 }
 
+func TestAccStorageBucket_domainNameAsCDNOrigin(t *testing.T) {
+	rInt := acctest.RandInt()
+	resourceName := "yandex_storage_bucket.test"
+	originGroupName := "yandex_cdn_origin_group.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProviderFactoriesV6,
+		CheckDestroy: resource.ComposeTestCheckFunc(
+			testAccCheckStorageBucketDestroy,
+			testAccCheckCDNOriginGroupDestroy,
+		),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccStorageBucketDomainNameAsCDNOriginConfig(rInt),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckStorageBucketExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "bucket_domain_name", testAccBucketDomainName(rInt)),
+					resource.TestCheckTypeSetElemNestedAttrs(originGroupName, "origin.*", map[string]string{
+						"source": testAccBucketDomainName(rInt),
+					}),
+				),
+			},
+		},
+	})
+}
+
 func TestAccStorageBucket_namePrefix(t *testing.T) {
 	rInt := acctest.RandInt()
 	resourceName := "yandex_storage_bucket.test"
@@ -892,6 +919,26 @@ func TestAccStorageBucket_ObjectLockWithDefaultRetentionYears(t *testing.T) {
 	})
 }
 
+func TestAccStorageBucket_ObjectLockInvalidDefaultRetention(t *testing.T) {
+	rInt := acctest.RandInt()
+
+	steps := []resource.TestStep{}
+	for _, retention := range []string{"days = 0", "days = -1", "years = 0", "years = -1"} {
+		steps = append(steps, resource.TestStep{
+			Config:      testAccStorageBucketConfigWithObjectLockRetention(rInt, retention),
+			PlanOnly:    true,
+			ExpectError: regexp.MustCompile(`to be at least \(1\)`),
+		})
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProviderFactoriesV6,
+		CheckDestroy:             testAccCheckStorageBucketDestroy,
+		Steps:                    steps,
+	})
+}
+
 func TestAccStorageBucket_Tagging(t *testing.T) {
 	rInt := acctest.RandInt()
 	resourceName := "yandex_storage_bucket.test"
@@ -1570,6 +1617,11 @@ func (b testAccStorageBucketConfigBuilder) before(statement string) testAccStora
 	return b
 }
 
+func (b testAccStorageBucketConfigBuilder) after(statement string) testAccStorageBucketConfigBuilder {
+	b.afterBucket = append(b.afterBucket, statement)
+	return b
+}
+
 func (b testAccStorageBucketConfigBuilder) asEditor() testAccStorageBucketConfigBuilder {
 	b.role = testAccStorageBucketConfigBuilderRoleEditor
 	return b
@@ -1698,6 +1750,19 @@ func testAccStorageBucketConfig(randInt int) string {
 		render()
 }
 
+func testAccStorageBucketDomainNameAsCDNOriginConfig(randInt int) string {
+	return newBucketConfigBuilder(randInt).
+		after(fmt.Sprintf(`resource "yandex_cdn_origin_group" "test" {
+	name = "tf-test-cdn-group-%d"
+
+	origin {
+		source = yandex_storage_bucket.test.bucket_domain_name
+	}
+}`, randInt)).
+		asEditor().
+		render()
+}
+
 func testAccStorageBucketWithoutAWSKeysConfig(randInt int) string {
 	return newBucketConfigBuilder(randInt).
 		withDisabledAccessKeys().
@@ -1880,6 +1945,26 @@ func testAccStorageBucketConfigWithObjectLock(randInt int, mode string, days int
 		render()
 }
 
+func testAccStorageBucketConfigWithObjectLockRetention(randInt int, retention string) string {
+	objectLockConfig := fmt.Sprintf(`object_lock_configuration {
+		object_lock_enabled = "Enabled"
+		rule {
+			default_retention {
+				mode = "%s"
+				%s
+			}
+		}
+	}`, awsS3.ObjectLockModeGovernance, retention)
+
+	return newBucketConfigBuilder(randInt).
+		addStatement(`versioning {
+		enabled = true
+	}`).
+		addStatement(objectLockConfig).
+		asAdmin().
+		render()
+}
+
 func testAccStorageBucketConfigWithCORS(randInt int) string {
 	const cors = `cors_rule {
 		allowed_headers = ["*"]
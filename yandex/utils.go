@@ -823,6 +823,12 @@ func convertResourceToDataSource(resource *schema.Resource) *schema.Resource {
 		schema.ValidateFunc = nil
 		schema.MaxItems = 0
 		schema.MinItems = 0
+		schema.ConflictsWith = nil
+		schema.ExactlyOneOf = nil
+		schema.AtLeastOneOf = nil
+		schema.RequiredWith = nil
+		schema.DiffSuppressFunc = nil
+		schema.StateFunc = nil
 	})
 }
 
@@ -137,3 +137,23 @@ func validateS3BucketLifecycleTimestamp(v interface{}, k string) (ws []string, e
 
 	return
 }
+
+func validateFutureRFC3339Timestamp(v interface{}, k string) (ws []string, errors []error) {
+	value, ok := v.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected type of %q to be string", k))
+		return
+	}
+
+	t, err := time.Parse(time.RFC3339, value)
+	if err != nil {
+		errors = append(errors, fmt.Errorf("%q cannot be parsed as RFC3339 Timestamp Format", value))
+		return
+	}
+
+	if !t.After(time.Now()) {
+		errors = append(errors, fmt.Errorf("expected %q to be in the future, got %q", k, value))
+	}
+
+	return
+}
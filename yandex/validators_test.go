@@ -5,6 +5,7 @@ import (
 	"regexp"
 	"strconv"
 	"testing"
+	"time"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/stretchr/testify/assert"
@@ -175,6 +176,45 @@ func TestFloatAtLeast(t *testing.T) {
 	}
 }
 
+func TestValidateFutureRFC3339Timestamp(t *testing.T) {
+	testCases := []struct {
+		val         interface{}
+		expectedErr *regexp.Regexp
+	}{
+		{
+			val: time.Now().Add(time.Hour).Format(time.RFC3339),
+		},
+		{
+			val:         time.Now().Add(-time.Hour).Format(time.RFC3339),
+			expectedErr: regexp.MustCompile("expected \"test_property\" to be in the future"),
+		},
+		{
+			val:         "2099-11-11",
+			expectedErr: regexp.MustCompile("cannot be parsed as RFC3339 Timestamp Format"),
+		},
+		{
+			val:         1,
+			expectedErr: regexp.MustCompile("expected type of \"test_property\" to be string"),
+		},
+	}
+
+	for i, tc := range testCases {
+		_, errs := validateFutureRFC3339Timestamp(tc.val, "test_property")
+
+		if len(errs) == 0 && tc.expectedErr == nil {
+			continue
+		}
+
+		if len(errs) != 0 && tc.expectedErr == nil {
+			t.Fatalf("expected test case %d to produce no errors, got %v", i, errs)
+		}
+
+		if !matchErr(errs, tc.expectedErr) {
+			t.Fatalf("expected test case %d to produce error matching \"%s\", got %v", i, tc.expectedErr, errs)
+		}
+	}
+}
+
 func matchErr(errs []error, r *regexp.Regexp) bool {
 	// err must match one provided
 	for _, err := range errs {